@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const optionPath = "github.com/antoniszymanski/option-go"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "uncheckedunwrap",
+	Doc:      "report Unwrap and Expect calls on option.Option that are not guarded by an IsSome, IsNone or Get check",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+type checker struct {
+	pass *analysis.Pass
+	ok   map[types.Object]string // ok variables from "v, ok := x.Get()"
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	c := &checker{pass: pass, ok: make(map[types.Object]string)}
+
+	inspect.Preorder([]ast.Node{(*ast.AssignStmt)(nil)}, func(n ast.Node) {
+		assign := n.(*ast.AssignStmt)
+		if len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			return
+		}
+		recv, method, ok := c.optionCall(assign.Rhs[0])
+		if !ok || method != "Get" {
+			return
+		}
+		if ident, ok := assign.Lhs[1].(*ast.Ident); ok {
+			if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
+				c.ok[obj] = recv
+			}
+		}
+	})
+
+	inspect.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		call := n.(*ast.CallExpr)
+		recv, method, ok := c.optionCall(call)
+		if !ok || (method != "Unwrap" && method != "Expect") {
+			return true
+		}
+		if c.isSomeLiteral(call.Fun.(*ast.SelectorExpr).X) {
+			return true
+		}
+		if !c.guarded(recv, stack) {
+			pass.Reportf(call.Pos(), "call to %s on option %s is not guarded by an IsSome, IsNone or Get check", method, recv)
+		}
+		return true
+	})
+	return nil, nil
+}
+
+func (c *checker) optionCall(expr ast.Expr) (recv, method string, ok bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return "", "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	fn, ok := c.pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok {
+		return "", "", false
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() == nil || !isOption(sig.Recv().Type()) {
+		return "", "", false
+	}
+	return types.ExprString(sel.X), sel.Sel.Name, true
+}
+
+func isOption(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == optionPath && obj.Name() == "Option"
+}
+
+func (c *checker) isSomeLiteral(expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.IndexExpr:
+		if sel, ok := fun.X.(*ast.SelectorExpr); ok {
+			ident = sel.Sel
+		}
+	case *ast.Ident:
+		ident = fun
+	}
+	if ident == nil {
+		return false
+	}
+	fn, ok := c.pass.TypesInfo.Uses[ident].(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == optionPath && fn.Name() == "Some"
+}
+
+func (c *checker) guarded(recv string, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1]
+		switch node := stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if child == node.Body && c.someWhen(node.Cond, true, recv) {
+				return true
+			}
+			if child == node.Else && c.someWhen(node.Cond, false, recv) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child != node.Y {
+				continue
+			}
+			if node.Op == token.LAND && c.someWhen(node.X, true, recv) {
+				return true
+			}
+			if node.Op == token.LOR && c.someWhen(node.X, false, recv) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if c.earlyExit(node.List, child, recv) {
+				return true
+			}
+		case *ast.CaseClause:
+			if c.earlyExit(node.Body, child, recv) {
+				return true
+			}
+		case *ast.CommClause:
+			if c.earlyExit(node.Body, child, recv) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *checker) earlyExit(stmts []ast.Stmt, child ast.Node, recv string) bool {
+	for _, stmt := range stmts {
+		if stmt == child {
+			return false
+		}
+		s, ok := stmt.(*ast.IfStmt)
+		if !ok || s.Else != nil || !terminates(s.Body) {
+			continue
+		}
+		if c.someWhen(s.Cond, false, recv) {
+			return true
+		}
+	}
+	return false
+}
+
+func terminates(body *ast.BlockStmt) bool {
+	if len(body.List) == 0 {
+		return false
+	}
+	switch stmt := body.List[len(body.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		return ok && ident.Name == "panic"
+	default:
+		return false
+	}
+}
+
+func (c *checker) someWhen(cond ast.Expr, truth bool, recv string) bool {
+	switch cond := ast.Unparen(cond).(type) {
+	case *ast.UnaryExpr:
+		return cond.Op == token.NOT && c.someWhen(cond.X, !truth, recv)
+	case *ast.BinaryExpr:
+		switch {
+		case cond.Op == token.LAND && truth, cond.Op == token.LOR && !truth:
+			return c.someWhen(cond.X, truth, recv) || c.someWhen(cond.Y, truth, recv)
+		}
+		return false
+	case *ast.Ident:
+		obj := c.pass.TypesInfo.ObjectOf(cond)
+		return truth && obj != nil && c.ok[obj] == recv
+	case *ast.CallExpr:
+		r, method, ok := c.optionCall(cond)
+		if !ok || r != recv {
+			return false
+		}
+		switch method {
+		case "IsSome", "IsSomeAnd":
+			return truth
+		case "IsNone":
+			return !truth
+		}
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package analyzer
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "a")
+}
@@ -0,0 +1,44 @@
+package a
+
+import "github.com/antoniszymanski/option-go"
+
+func guarded(o option.Option[int]) int {
+	if o.IsSome() {
+		return o.Unwrap()
+	}
+	if !o.IsNone() {
+		return o.Expect("checked")
+	}
+	if o.IsSome() && o.Unwrap() > 0 {
+		return 1
+	}
+	if o.IsNone() || o.Unwrap() > 0 {
+		return 2
+	}
+	if _, ok := o.Get(); ok {
+		return o.Unwrap()
+	}
+	return option.Some(3).Unwrap()
+}
+
+func earlyExit(o option.Option[int]) int {
+	if o.IsNone() {
+		return 0
+	}
+	return o.Unwrap()
+}
+
+func unguarded(o, p option.Option[int]) int {
+	n := o.Unwrap() // want `call to Unwrap on option o is not guarded by an IsSome, IsNone or Get check`
+	if p.IsSome() {
+		n += o.Expect("wrong option") // want `call to Expect on option o is not guarded by an IsSome, IsNone or Get check`
+	} else {
+		n += p.Unwrap() // want `call to Unwrap on option p is not guarded by an IsSome, IsNone or Get check`
+	}
+	if o.IsSome() {
+		func() {
+			n += o.Unwrap() // want `call to Unwrap on option o is not guarded by an IsSome, IsNone or Get check`
+		}()
+	}
+	return n
+}
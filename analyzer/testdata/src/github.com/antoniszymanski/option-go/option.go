@@ -0,0 +1,22 @@
+package option
+
+type Option[T any] struct {
+	valid bool
+	value T
+}
+
+func Some[T any](value T) Option[T] { return Option[T]{valid: true, value: value} }
+
+func None[T any]() Option[T] { return Option[T]{} }
+
+func (o Option[T]) IsSome() bool { return o.valid }
+
+func (o Option[T]) IsNone() bool { return !o.valid }
+
+func (o Option[T]) IsSomeAnd(f func(T) bool) bool { return o.valid && f(o.value) }
+
+func (o Option[T]) Get() (T, bool) { return o.value, o.valid }
+
+func (o Option[T]) Unwrap() T { return o.value }
+
+func (o Option[T]) Expect(msg string) T { return o.value }
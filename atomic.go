@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "sync/atomic"
+
+type Atomic[T any] struct {
+	p atomic.Pointer[Option[T]]
+}
+
+func NewAtomic[T any](o Option[T]) *Atomic[T] {
+	a := new(Atomic[T])
+	a.Store(o)
+	return a
+}
+
+func (a *Atomic[T]) Load() Option[T] {
+	if p := a.p.Load(); p != nil {
+		return *p
+	} else {
+		return Option[T]{}
+	}
+}
+
+func (a *Atomic[T]) IsSome() bool {
+	return a.Load().valid
+}
+
+func (a *Atomic[T]) Get() (T, bool) {
+	o := a.Load()
+	return o.value, o.valid
+}
+
+func (a *Atomic[T]) Store(o Option[T]) {
+	a.p.Store(&o)
+}
+
+func (a *Atomic[T]) Swap(o Option[T]) Option[T] {
+	if p := a.p.Swap(&o); p != nil {
+		return *p
+	} else {
+		return Option[T]{}
+	}
+}
+
+func CompareAndSwap[T comparable](a *Atomic[T], old, new Option[T]) bool {
+	p := &new
+	for {
+		q := a.p.Load()
+		var current Option[T]
+		if q != nil {
+			current = *q
+		}
+		if current.valid != old.valid || (old.valid && current.value != old.value) {
+			return false
+		}
+		if a.p.CompareAndSwap(q, p) {
+			return true
+		}
+	}
+}
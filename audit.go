@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-json-experiment/json"
+)
+
+type Actor struct {
+	Option[string]
+}
+
+var (
+	_ sql.Scanner    = &Actor{}
+	_ driver.Valuer  = Actor{}
+	_ slog.LogValuer = Actor{}
+)
+
+func NewActor(id string) Actor {
+	return Actor{Some(id)}
+}
+
+func (a Actor) Validate() error {
+	if !a.valid {
+		return nil
+	}
+	switch {
+	case a.value == "":
+		return errors.New("option: empty actor")
+	case len(a.value) > 256:
+		return errors.New("option: actor longer than 256 bytes")
+	case !utf8.ValidString(a.value):
+		return errors.New("option: actor is not valid UTF-8")
+	}
+	for _, r := range a.value {
+		if unicode.IsControl(r) {
+			return errors.New("option: actor contains control characters")
+		}
+	}
+	return nil
+}
+
+func (a Actor) Redacted() string {
+	if !a.valid {
+		return ""
+	}
+	r, _ := utf8.DecodeRuneInString(a.value)
+	return string(r) + "***"
+}
+
+func (a Actor) String() string {
+	if a.valid {
+		return "Some(" + a.Redacted() + ")"
+	} else {
+		return "None"
+	}
+}
+
+func (a Actor) LogValue() slog.Value {
+	return slog.StringValue(a.Redacted())
+}
+
+type Audited[T any] struct {
+	Data  T         `json:"data"`
+	Actor Actor     `json:"actor,omitzero"`
+	At    time.Time `json:"at"`
+}
+
+var (
+	_ sql.Scanner   = &Audited[int]{}
+	_ driver.Valuer = Audited[int]{}
+)
+
+func Audit[T any](data T, actor Actor, at time.Time) Audited[T] {
+	return Audited[T]{Data: data, Actor: actor, At: at}
+}
+
+func (a *Audited[T]) Scan(src any) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, a)
+	case string:
+		return json.Unmarshal([]byte(src), a)
+	default:
+		return fmt.Errorf("option: cannot scan %T into Audited", src)
+	}
+}
+
+func (a Audited[T]) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
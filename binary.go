@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"io"
+	"math"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Option[int]{}
+	_ encoding.BinaryUnmarshaler = &Option[int]{}
+	_ gob.GobEncoder             = Option[int]{}
+	_ gob.GobDecoder             = &Option[int]{}
+)
+
+const (
+	binaryNone byte = 0
+	binarySome byte = 1
+)
+
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	if !o.valid {
+		return []byte{binaryNone}, nil
+	}
+	data, err := marshalBinaryValue(&o.value)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{binarySome}, data...), nil
+}
+
+func (o Option[T]) MarshalBinaryTo(w io.Writer) error {
+	if !o.valid {
+		_, err := w.Write([]byte{binaryNone})
+		return err
+	}
+	data, err := marshalBinaryValue(&o.value)
+	if err != nil {
+		return err
+	}
+	buf := binary.AppendUvarint([]byte{binarySome}, uint64(len(data)))
+	_, err = w.Write(append(buf, data...))
+	return err
+}
+
+func marshalBinaryValue[T any](p *T) ([]byte, error) {
+	if m, ok := marshalerOf[encoding.BinaryMarshaler](p); ok {
+		return m.MarshalBinary()
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(p); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("option: binary data is empty")
+	}
+	switch data[0] {
+	case binaryNone:
+		if len(data) != 1 {
+			return errors.New("option: unexpected payload after None")
+		}
+		*o = Option[T]{}
+		return nil
+	case binarySome:
+		value, err := unmarshalBinaryValue[T](data[1:])
+		if err != nil {
+			return err
+		}
+		*o = Option[T]{valid: true, value: value}
+		return nil
+	default:
+		return errors.New("option: invalid binary validity byte")
+	}
+}
+
+func (o *Option[T]) UnmarshalBinaryFrom(r io.Reader) error {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = byteReader{r}
+	}
+	validity, err := br.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch validity {
+	case binaryNone:
+		*o = Option[T]{}
+		return nil
+	case binarySome:
+		n, err := binary.ReadUvarint(br)
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(io.LimitReader(r, int64(min(n, math.MaxInt64))))
+		if err != nil {
+			return err
+		}
+		if uint64(len(data)) != n {
+			return io.ErrUnexpectedEOF
+		}
+		value, err := unmarshalBinaryValue[T](data)
+		if err != nil {
+			return err
+		}
+		*o = Option[T]{valid: true, value: value}
+		return nil
+	default:
+		return errors.New("option: invalid binary validity byte")
+	}
+}
+
+func unmarshalBinaryValue[T any](data []byte) (T, error) {
+	var value T
+	if u, ok := unmarshalerOf[encoding.BinaryUnmarshaler](&value); ok {
+		err := u.UnmarshalBinary(data)
+		return value, err
+	}
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value)
+	return value, err
+}
+
+type byteReader struct {
+	io.Reader
+}
+
+func (r byteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(r.Reader, b[:])
+	return b[0], err
+}
+
+func (o Option[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+func (o *Option[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
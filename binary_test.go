@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestBinaryStream(t *testing.T) {
+	ints := []Option[int]{Some(1), None[int](), Some(-300)}
+	times := []Option[time.Time]{Some(time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)), None[time.Time](), Some(time.Unix(0, 0).UTC())}
+	var buf bytes.Buffer
+	for i := range ints {
+		if err := ints[i].MarshalBinaryTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if err := times[i].MarshalBinaryTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, r := range map[string]io.Reader{
+		"ByteReader": bytes.NewReader(buf.Bytes()),
+		"Reader":     struct{ io.Reader }{bytes.NewReader(buf.Bytes())}, // hides ReadByte
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := range ints {
+				var gotInt Option[int]
+				if err := gotInt.UnmarshalBinaryFrom(r); err != nil {
+					t.Fatal(err)
+				}
+				if gotInt != ints[i] {
+					t.Errorf("value %d: got %v, want %v", i, gotInt, ints[i])
+				}
+				var gotTime Option[time.Time]
+				if err := gotTime.UnmarshalBinaryFrom(r); err != nil {
+					t.Fatal(err)
+				}
+				if gotTime != times[i] {
+					t.Errorf("value %d: got %v, want %v", i, gotTime, times[i])
+				}
+			}
+			var extra Option[int]
+			if err := extra.UnmarshalBinaryFrom(r); !errors.Is(err, io.EOF) {
+				t.Errorf("reading past the last value: got %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestBinaryStreamTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Some("hello").MarshalBinaryTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var o Option[string]
+	if err := o.UnmarshalBinaryFrom(bytes.NewReader(buf.Bytes()[:buf.Len()-1])); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("got %v, want io.ErrUnexpectedEOF", err)
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type BreakerHooks struct {
+	OnReject      func()
+	OnSuccess     func()
+	OnFailure     func(error)
+	OnStateChange func(open bool)
+}
+
+type Breaker[T any] struct {
+	threshold int
+	cooldown  time.Duration
+	hooks     BreakerHooks
+
+	mu        sync.Mutex
+	failures  int
+	open      bool
+	openUntil time.Time
+	probing   atomic.Bool // a half-open trial call is in flight
+}
+
+func NewBreaker[T any](threshold int, cooldown time.Duration, hooks BreakerHooks) *Breaker[T] {
+	if threshold <= 0 {
+		panic("option: non-positive Breaker threshold")
+	}
+	return &Breaker[T]{threshold: threshold, cooldown: cooldown, hooks: hooks}
+}
+
+func (b *Breaker[T]) Do(f func() (T, error)) (Option[T], error) {
+	ok, probe := b.allow()
+	if !ok {
+		if b.hooks.OnReject != nil {
+			b.hooks.OnReject()
+		}
+		return Option[T]{}, nil
+	}
+	if probe {
+		defer b.probing.Store(false)
+	}
+	value, err := f()
+	b.record(err)
+	if err != nil {
+		if b.hooks.OnFailure != nil {
+			b.hooks.OnFailure(err)
+		}
+		return Option[T]{}, err
+	}
+	if b.hooks.OnSuccess != nil {
+		b.hooks.OnSuccess()
+	}
+	return Some(value), nil
+}
+
+func (b *Breaker[T]) IsOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.open
+}
+
+func (b *Breaker[T]) allow() (ok, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return true, false
+	}
+	if time.Now().Before(b.openUntil) {
+		return false, false
+	}
+	ok = b.probing.CompareAndSwap(false, true)
+	return ok, ok
+}
+
+func (b *Breaker[T]) record(err error) {
+	b.mu.Lock()
+	wasOpen := b.open
+	if err != nil {
+		b.failures++
+		if b.failures >= b.threshold {
+			b.open = true
+			b.openUntil = time.Now().Add(b.cooldown)
+		}
+	} else {
+		b.failures = 0
+		b.open = false
+	}
+	open := b.open
+	b.mu.Unlock()
+	if open != wasOpen && b.hooks.OnStateChange != nil {
+		b.hooks.OnStateChange(open)
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBreakerHalfOpenSingleProbe(t *testing.T) {
+	b := NewBreaker[int](1, time.Millisecond, BreakerHooks{})
+	if _, err := b.Do(func() (int, error) { return 0, errors.New("boom") }); err == nil {
+		t.Fatal("Do did not return the failure")
+	}
+	if !b.IsOpen() {
+		t.Fatal("breaker did not open after reaching the threshold")
+	}
+	time.Sleep(2 * time.Millisecond)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	probe := func() (int, error) {
+		calls.Add(1)
+		<-release
+		return 1, nil
+	}
+	var probeDone sync.WaitGroup
+	probeDone.Go(func() { b.Do(probe) })
+	for calls.Load() == 0 {
+		time.Sleep(time.Microsecond)
+	}
+	var rejected sync.WaitGroup
+	for range 8 {
+		rejected.Go(func() {
+			o, err := b.Do(func() (int, error) {
+				calls.Add(1)
+				return 2, nil
+			})
+			if o.IsSome() || err != nil {
+				t.Errorf("half-open breaker let a second call through: %v, %v", o, err)
+			}
+		})
+	}
+	rejected.Wait()
+	close(release)
+	probeDone.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Errorf("half-open breaker ran %d trial calls, want 1", n)
+	}
+	if b.IsOpen() {
+		t.Error("breaker still open after a successful probe")
+	}
+}
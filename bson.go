@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_bson
+
+package option
+
+import "go.mongodb.org/mongo-driver/v2/bson"
+
+var (
+	_ bson.ValueMarshaler   = Option[int]{}
+	_ bson.ValueUnmarshaler = &Option[int]{}
+)
+
+func (o Option[T]) MarshalBSONValue() (byte, []byte, error) {
+	if o.valid {
+		typ, data, err := bson.MarshalValue(&o.value)
+		return byte(typ), data, err
+	} else {
+		return byte(bson.TypeNull), nil, nil
+	}
+}
+
+func (o *Option[T]) UnmarshalBSONValue(typ byte, data []byte) error {
+	if t := bson.Type(typ); t == bson.TypeNull || t == bson.TypeUndefined {
+		*o = Option[T]{}
+		return nil
+	}
+	if err := bson.UnmarshalValue(bson.Type(typ), data, &o.value); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	o.valid = true
+	return nil
+}
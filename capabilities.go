@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+type Capabilities map[string]Option[string]
+
+func (c Capabilities) Supports(name string) bool {
+	_, ok := c[name]
+	return ok
+}
+
+func (c Capabilities) Setting(name string) Option[string] {
+	return c[name]
+}
+
+func Negotiate(local, remote Capabilities) Capabilities {
+	negotiated := make(Capabilities)
+	for name, l := range local {
+		r, ok := remote[name]
+		switch {
+		case !ok:
+		case !l.valid:
+			negotiated[name] = r
+		case !r.valid || l.value == r.value:
+			negotiated[name] = l
+		}
+	}
+	return negotiated
+}
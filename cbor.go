@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_cbor
+
+package option
+
+import "github.com/fxamacker/cbor/v2"
+
+var (
+	_ cbor.Marshaler   = Option[int]{}
+	_ cbor.Unmarshaler = &Option[int]{}
+)
+
+const (
+	cborNull      = 0xf6
+	cborUndefined = 0xf7
+)
+
+func (o Option[T]) MarshalCBOR() ([]byte, error) {
+	if o.valid {
+		return cbor.Marshal(&o.value)
+	} else {
+		return []byte{cborNull}, nil
+	}
+}
+
+func (o *Option[T]) UnmarshalCBOR(data []byte) error {
+	if len(data) == 1 && (data[0] == cborNull || data[0] == cborUndefined) {
+		*o = Option[T]{}
+		return nil
+	}
+	if err := cbor.Unmarshal(data, &o.value); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	o.valid = true
+	return nil
+}
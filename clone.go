@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "reflect"
+
+func (o Option[T]) Clone() Option[T] {
+	if !o.valid {
+		return Option[T]{}
+	}
+	if c, ok := any(&o.value).(interface{ Clone() T }); ok {
+		return Option[T]{valid: true, value: c.Clone()}
+	}
+	v := reflect.ValueOf(&o.value).Elem()
+	clone := deepCopy(v, make(map[uintptr]reflect.Value))
+	value, _ := clone.Interface().(T) // nil when T is an interface holding nil
+	return Option[T]{valid: true, value: value}
+}
+
+func CloneFunc[T any](o Option[T], clone func(T) T) Option[T] {
+	if o.valid {
+		return Option[T]{valid: true, value: clone(o.value)}
+	} else {
+		return Option[T]{}
+	}
+}
+
+func deepCopy(v reflect.Value, seen map[uintptr]reflect.Value) reflect.Value {
+	if c, ok := callClone(v); ok {
+		return c
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		if c, ok := seen[v.Pointer()]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		seen[v.Pointer()] = c
+		c.Elem().Set(deepCopy(v.Elem(), seen))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(deepCopy(iter.Key(), seen), deepCopy(iter.Value(), seen))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopy(v.Index(i), seen))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range v.NumField() {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i), seen))
+			}
+		}
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem(), seen))
+		return c
+	default:
+		return v
+	}
+}
+
+func callClone(v reflect.Value) (reflect.Value, bool) {
+	if !v.CanInterface() { // methods of values read from unexported fields cannot be called
+		return reflect.Value{}, false
+	}
+	m := v.MethodByName("Clone")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0) != v.Type() {
+		return reflect.Value{}, false
+	}
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return v, true
+	}
+	return m.Call(nil)[0], true
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "testing"
+
+type cloneOuter struct {
+	In Option[[]int]
+}
+
+func TestCloneNestedOption(t *testing.T) {
+	s := []int{1, 2, 3}
+	o := Some(cloneOuter{In: Some(s)})
+	c := o.Clone()
+	s[0] = 100
+	if got := c.Unwrap().In.Unwrap()[0]; got != 1 {
+		t.Errorf("clone shares the nested slice: got %d, want 1", got)
+	}
+}
+
+func TestCloneNestedOptionSlice(t *testing.T) {
+	m := map[string]int{"a": 1}
+	o := Some([]Option[map[string]int]{Some(m), None[map[string]int]()})
+	c := o.Clone()
+	m["a"] = 100
+	if got := c.Unwrap()[0].Unwrap()["a"]; got != 1 {
+		t.Errorf("clone shares the nested map: got %d, want 1", got)
+	}
+	if c.Unwrap()[1].IsSome() {
+		t.Error("clone turned None into Some")
+	}
+}
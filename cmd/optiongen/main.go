@@ -0,0 +1,499 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+type instance struct {
+	Name string
+	Type string
+}
+
+type config struct {
+	Package   string
+	Imports   []string
+	Interop   bool
+	Instances []instance
+}
+
+type imports []string
+
+func (i *imports) String() string {
+	return strings.Join(*i, ",")
+}
+
+func (i *imports) Set(s string) error {
+	*i = append(*i, s)
+	return nil
+}
+
+func main() {
+	var cfg config
+	var output string
+	var extra imports
+	flag.StringVar(&cfg.Package, "package", "", "package name of the generated file")
+	flag.StringVar(&output, "o", "", "output file (default: standard output)")
+	flag.BoolVar(&cfg.Interop, "interop", false, "generate conversions to and from option.Option and codec methods that delegate to it")
+	flag.Var(&extra, "import", "import path needed by the value types (repeatable)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: optiongen -package name [flags] Name=Type...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if cfg.Package == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	for _, arg := range flag.Args() {
+		name, typ, ok := strings.Cut(arg, "=")
+		if !ok || name == "" || typ == "" {
+			fmt.Fprintf(os.Stderr, "optiongen: invalid type specification %q, want Name=Type\n", arg)
+			os.Exit(2)
+		}
+		cfg.Instances = append(cfg.Instances, instance{Name: name, Type: typ})
+	}
+	if cfg.Interop {
+		cfg.Imports = append(cfg.Imports,
+			"database/sql/driver",
+			"encoding/xml",
+			"github.com/antoniszymanski/option-go",
+			"github.com/go-json-experiment/json",
+			"github.com/go-json-experiment/json/jsontext",
+		)
+	} else {
+		cfg.Imports = append(cfg.Imports, "encoding/json")
+	}
+	cfg.Imports = append(cfg.Imports, "fmt")
+	cfg.Imports = append(cfg.Imports, extra...)
+	slices.Sort(cfg.Imports)
+	cfg.Imports = slices.Compact(cfg.Imports)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		fmt.Fprintln(os.Stderr, "optiongen:", err)
+		os.Exit(1)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "optiongen:", err)
+		os.Exit(1)
+	}
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(output, src, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "optiongen:", err)
+		os.Exit(1)
+	}
+}
+
+var tmpl = template.Must(template.New("").Parse(`// Code generated by optiongen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Instances}}
+type Option{{.Name}} struct {
+	valid bool
+	value {{.Type}}
+}
+
+func Some{{.Name}}(value {{.Type}}) Option{{.Name}} {
+	return Option{{.Name}}{valid: true, value: value}
+}
+
+func None{{.Name}}() Option{{.Name}} {
+	return Option{{.Name}}{}
+}
+{{if $.Interop}}
+func NewOption{{.Name}}(o option.Option[{{.Type}}]) Option{{.Name}} {
+	return Option{{.Name}}{valid: o.IsSome(), value: o.UnwrapOrZero()}
+}
+
+func (o Option{{.Name}}) Option() option.Option[{{.Type}}] {
+	if o.valid {
+		return option.Some(o.value)
+	} else {
+		return option.None[{{.Type}}]()
+	}
+}
+{{end}}
+func (o Option{{.Name}}) IsSome() bool {
+	return o.valid
+}
+
+func (o Option{{.Name}}) IsNone() bool {
+	return !o.valid
+}
+
+func (o Option{{.Name}}) Get() ({{.Type}}, bool) {
+	return o.value, o.valid
+}
+
+func (o Option{{.Name}}) Expect(msg string) {{.Type}} {
+	if o.valid {
+		return o.value
+	} else {
+		panic(msg)
+	}
+}
+
+func (o Option{{.Name}}) Unwrap() {{.Type}} {
+	if o.valid {
+		return o.value
+	} else {
+		panic("called Unwrap on a None value")
+	}
+}
+
+func (o Option{{.Name}}) UnwrapOr(fallback {{.Type}}) {{.Type}} {
+	if o.valid {
+		return o.value
+	} else {
+		return fallback
+	}
+}
+
+func (o Option{{.Name}}) UnwrapOrZero() {{.Type}} {
+	return o.value
+}
+
+func (o Option{{.Name}}) UnwrapOrElse(f func() {{.Type}}) {{.Type}} {
+	if o.valid {
+		return o.value
+	} else {
+		return f()
+	}
+}
+
+func (o Option{{.Name}}) IsSomeAnd(f func({{.Type}}) bool) bool {
+	return o.valid && f(o.value)
+}
+
+func (o Option{{.Name}}) IsNoneOr(f func({{.Type}}) bool) bool {
+	return !o.valid || f(o.value)
+}
+
+func (o Option{{.Name}}) AsSlice() []{{.Type}} {
+	if o.valid {
+		return []{{.Type}}{o.value}
+	} else {
+		return nil
+	}
+}
+
+func (o *Option{{.Name}}) TakeOk() ({{.Type}}, bool) {
+	value, ok := o.value, o.valid
+	*o = Option{{.Name}}{}
+	return value, ok
+}
+
+func (o *Option{{.Name}}) Set(value {{.Type}}) {
+	o.valid = true
+	o.value = value
+}
+
+func (o *Option{{.Name}}) SetNone() {
+	var zero {{.Type}}
+	o.valid = false
+	o.value = zero
+}
+
+func (o *Option{{.Name}}) Reset() {
+	o.SetNone()
+}
+
+func (o *Option{{.Name}}) Insert(value {{.Type}}) *{{.Type}} {
+	*o = Option{{.Name}}{valid: true, value: value}
+	return &o.value
+}
+
+func (o *Option{{.Name}}) GetOrInsert(value {{.Type}}) *{{.Type}} {
+	if !o.valid {
+		*o = Option{{.Name}}{valid: true, value: value}
+	}
+	return &o.value
+}
+
+func (o *Option{{.Name}}) GetOrInsertWith(f func() {{.Type}}) *{{.Type}} {
+	if !o.valid {
+		*o = Option{{.Name}}{valid: true, value: f()}
+	}
+	return &o.value
+}
+
+func (o *Option{{.Name}}) Replace(value {{.Type}}) Option{{.Name}} {
+	old := *o
+	*o = Option{{.Name}}{valid: true, value: value}
+	return old
+}
+
+func (o *Option{{.Name}}) Take() Option{{.Name}} {
+	old := *o
+	*o = Option{{.Name}}{}
+	return old
+}
+
+func (o Option{{.Name}}) Ptr() *{{.Type}} {
+	if o.valid {
+		return &o.value
+	} else {
+		return nil
+	}
+}
+
+func (o Option{{.Name}}) Filter(predicate func(*{{.Type}}) bool) Option{{.Name}} {
+	if o.valid && predicate(&o.value) {
+		return o
+	} else {
+		return Option{{.Name}}{}
+	}
+}
+
+func (o Option{{.Name}}) Inspect(f func(*{{.Type}})) Option{{.Name}} {
+	if o.valid {
+		f(&o.value)
+	}
+	return o
+}
+
+func (o Option{{.Name}}) IfSome(f func({{.Type}})) {
+	if o.valid {
+		f(o.value)
+	}
+}
+
+func (o Option{{.Name}}) IfNone(f func()) {
+	if !o.valid {
+		f()
+	}
+}
+
+func (o Option{{.Name}}) Match(some func({{.Type}}), none func()) {
+	if o.valid {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
+func (o Option{{.Name}}) Map(f func({{.Type}}) {{.Type}}) Option{{.Name}} {
+	if o.valid {
+		return Option{{.Name}}{valid: true, value: f(o.value)}
+	} else {
+		return Option{{.Name}}{}
+	}
+}
+
+func (o Option{{.Name}}) MapOr(fallback {{.Type}}, f func({{.Type}}) {{.Type}}) {{.Type}} {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback
+	}
+}
+
+func (o Option{{.Name}}) MapOrElse(fallback func() {{.Type}}, f func({{.Type}}) {{.Type}}) {{.Type}} {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback()
+	}
+}
+
+func (o Option{{.Name}}) And(other Option{{.Name}}) Option{{.Name}} {
+	if o.valid {
+		return other
+	} else {
+		return Option{{.Name}}{}
+	}
+}
+
+func (o Option{{.Name}}) Or(other Option{{.Name}}) Option{{.Name}} {
+	if o.valid {
+		return o
+	} else {
+		return other
+	}
+}
+
+func (o Option{{.Name}}) Xor(other Option{{.Name}}) Option{{.Name}} {
+	switch {
+	case o.valid && !other.valid:
+		return o
+	case !o.valid && other.valid:
+		return other
+	default:
+		return Option{{.Name}}{}
+	}
+}
+
+func (o Option{{.Name}}) AndThen(f func({{.Type}}) Option{{.Name}}) Option{{.Name}} {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return Option{{.Name}}{}
+	}
+}
+
+func (o Option{{.Name}}) OrElse(f func() Option{{.Name}}) Option{{.Name}} {
+	if o.valid {
+		return o
+	} else {
+		return f()
+	}
+}
+
+func (o Option{{.Name}}) String() string {
+	if o.valid {
+		return fmt.Sprintf("Some(%v)", o.value)
+	} else {
+		return "None"
+	}
+}
+
+func (o Option{{.Name}}) GoString() string {
+	if o.valid {
+		return fmt.Sprintf("{{if $.Interop}}option.Some({{else}}{{$.Package}}.Some{{.Name}}({{end}}%#v)", o.value)
+	} else {
+		return "{{if $.Interop}}option.None[{{.Type}}](){{else}}{{$.Package}}.None{{.Name}}(){{end}}"
+	}
+}
+{{if $.Interop}}
+func (o Option{{.Name}}) MarshalJSON() ([]byte, error) {
+	return o.Option().MarshalJSON()
+}
+
+func (o *Option{{.Name}}) UnmarshalJSON(data []byte) error {
+	var opt option.Option[{{.Type}}]
+	err := opt.UnmarshalJSON(data)
+	*o = NewOption{{.Name}}(opt)
+	return err
+}
+
+func (o Option{{.Name}}) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return o.Option().MarshalJSONTo(enc)
+}
+
+func (o *Option{{.Name}}) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var opt option.Option[{{.Type}}]
+	err := opt.UnmarshalJSONFrom(dec)
+	*o = NewOption{{.Name}}(opt)
+	return err
+}
+
+var (
+	_ json.Marshaler       = Option{{.Name}}{}
+	_ json.Unmarshaler     = &Option{{.Name}}{}
+	_ json.MarshalerTo     = Option{{.Name}}{}
+	_ json.UnmarshalerFrom = &Option{{.Name}}{}
+)
+
+func (o *Option{{.Name}}) Scan(src any) error {
+	var opt option.Option[{{.Type}}]
+	err := opt.Scan(src)
+	*o = NewOption{{.Name}}(opt)
+	return err
+}
+
+func (o Option{{.Name}}) Value() (driver.Value, error) {
+	return o.Option().Value()
+}
+
+func (o Option{{.Name}}) MarshalText() ([]byte, error) {
+	return o.Option().MarshalText()
+}
+
+func (o *Option{{.Name}}) UnmarshalText(text []byte) error {
+	var opt option.Option[{{.Type}}]
+	err := opt.UnmarshalText(text)
+	*o = NewOption{{.Name}}(opt)
+	return err
+}
+
+func (o Option{{.Name}}) MarshalBinary() ([]byte, error) {
+	return o.Option().MarshalBinary()
+}
+
+func (o *Option{{.Name}}) UnmarshalBinary(data []byte) error {
+	var opt option.Option[{{.Type}}]
+	err := opt.UnmarshalBinary(data)
+	*o = NewOption{{.Name}}(opt)
+	return err
+}
+
+func (o Option{{.Name}}) MarshalYAML() (any, error) {
+	return o.Option().MarshalYAML()
+}
+
+func (o *Option{{.Name}}) UnmarshalYAML(unmarshal func(any) error) error {
+	var opt option.Option[{{.Type}}]
+	err := opt.UnmarshalYAML(unmarshal)
+	*o = NewOption{{.Name}}(opt)
+	return err
+}
+
+func (o Option{{.Name}}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return o.Option().MarshalXML(e, start)
+}
+
+func (o *Option{{.Name}}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var opt option.Option[{{.Type}}]
+	err := opt.UnmarshalXML(d, start)
+	*o = NewOption{{.Name}}(opt)
+	return err
+}
+{{else}}
+func (o Option{{.Name}}) MarshalJSON() ([]byte, error) {
+	if o.valid {
+		return json.Marshal(o.value)
+	} else {
+		return []byte("null"), nil
+	}
+}
+
+func (o *Option{{.Name}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Option{{.Name}}{}
+		return nil
+	}
+	var value {{.Type}}
+	if err := json.Unmarshal(data, &value); err != nil {
+		*o = Option{{.Name}}{}
+		return err
+	}
+	*o = Option{{.Name}}{valid: true, value: value}
+	return nil
+}
+
+var (
+	_ json.Marshaler   = Option{{.Name}}{}
+	_ json.Unmarshaler = &Option{{.Name}}{}
+)
+{{end}}
+func (o Option{{.Name}}) IsZero() bool {
+	if !o.valid {
+		return true
+	}
+	if i, ok := any(o.value).(interface{ IsZero() bool }); ok {
+		return i.IsZero()
+	}
+	return false
+}
+{{end}}`))
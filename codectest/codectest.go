@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package codectest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/antoniszymanski/option-go"
+	"github.com/go-json-experiment/json"
+)
+
+type Codec[T any] interface {
+	Marshal(o option.Option[T]) ([]byte, error)
+	Unmarshal(data []byte, o *option.Option[T]) error
+}
+
+type InvalidInputs interface {
+	InvalidInputs() [][]byte
+}
+
+type Funcs[T any] struct {
+	MarshalFunc   func(o option.Option[T]) ([]byte, error)
+	UnmarshalFunc func(data []byte, o *option.Option[T]) error
+}
+
+func (f Funcs[T]) Marshal(o option.Option[T]) ([]byte, error) {
+	return f.MarshalFunc(o)
+}
+
+func (f Funcs[T]) Unmarshal(data []byte, o *option.Option[T]) error {
+	return f.UnmarshalFunc(data, o)
+}
+
+func JSON[T any]() Codec[T] {
+	return jsonCodec[T]{}
+}
+
+type jsonCodec[T any] struct{}
+
+func (jsonCodec[T]) Marshal(o option.Option[T]) ([]byte, error) {
+	return json.Marshal(&o)
+}
+
+func (jsonCodec[T]) Unmarshal(data []byte, o *option.Option[T]) error {
+	return json.Unmarshal(data, o)
+}
+
+func (jsonCodec[T]) InvalidInputs() [][]byte {
+	return [][]byte{[]byte("{"), []byte("nul"), []byte("1 2")}
+}
+
+func Run[T any](t *testing.T, codec Codec[T], samples []T) {
+	t.Helper()
+	t.Run("None", func(t *testing.T) {
+		got := roundTrip(t, codec, option.None[T](), option.Some(*new(T)))
+		if got.IsSome() {
+			t.Errorf("round trip of None = %#v, want None", got)
+		}
+	})
+	t.Run("Zero", func(t *testing.T) {
+		got := roundTrip(t, codec, option.Some(*new(T)), option.None[T]())
+		if nilable(reflect.TypeFor[T]()) && (got.IsNone() || empty(reflect.ValueOf(got.Unwrap()))) {
+			return
+		}
+		if !equal(got, option.Some(*new(T))) {
+			t.Errorf("round trip of Some(zero) = %#v, want %#v", got, option.Some(*new(T)))
+		}
+	})
+	t.Run("Samples", func(t *testing.T) {
+		for _, sample := range samples {
+			want := option.Some(sample)
+			if got := roundTrip(t, codec, want, option.None[T]()); !equal(got, want) {
+				t.Errorf("round trip of %#v = %#v", want, got)
+			}
+		}
+	})
+	if invalid, ok := codec.(InvalidInputs); ok {
+		t.Run("Invalid", func(t *testing.T) {
+			for _, data := range invalid.InvalidInputs() {
+				o := option.Some(*new(T))
+				if err := codec.Unmarshal(data, &o); err == nil {
+					t.Errorf("Unmarshal(%q) = %#v, want error", data, o)
+				}
+			}
+		})
+	}
+}
+
+func roundTrip[T any](t *testing.T, codec Codec[T], o, initial option.Option[T]) option.Option[T] {
+	t.Helper()
+	data, err := codec.Marshal(o)
+	if err != nil {
+		t.Fatalf("Marshal(%#v): %v", o, err)
+	}
+	got := initial
+	if err := codec.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal(%q): %v", data, err)
+	}
+	return got
+}
+
+func equal[T any](a, b option.Option[T]) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() == b.IsNone()
+	}
+	if eq, ok := any(a.Unwrap()).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(b.Unwrap())
+	}
+	return reflect.DeepEqual(a.Unwrap(), b.Unwrap())
+}
+
+func empty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	default:
+		return !v.IsValid() || v.IsZero()
+	}
+}
+
+func nilable(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
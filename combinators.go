@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+func Map[T, U any](o Option[T], f func(T) U) Option[U] {
+	if o.valid {
+		return Option[U]{valid: true, value: f(o.value)}
+	} else {
+		return Option[U]{}
+	}
+}
+
+func MapOr[T, U any](o Option[T], fallback U, f func(T) U) U {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback
+	}
+}
+
+func MapOrElse[T, U any](o Option[T], fallback func() U, f func(T) U) U {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback()
+	}
+}
+
+func Match[T, R any](o Option[T], some func(T) R, none func() R) R {
+	if o.valid {
+		return some(o.value)
+	} else {
+		return none()
+	}
+}
+
+func AndThen[T, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return Option[U]{}
+	}
+}
+
+func FlatMapPtr[T, U any](o Option[T], f func(T) *U) Option[U] {
+	if o.valid {
+		return FromPtr(f(o.value))
+	} else {
+		return Option[U]{}
+	}
+}
+
+func Flatten[T any](o Option[Option[T]]) Option[T] {
+	if o.valid {
+		return o.value
+	} else {
+		return Option[T]{}
+	}
+}
+
+func Flatten2[T any](o Option[Option[Option[T]]]) Option[T] {
+	return Flatten(Flatten(o))
+}
+
+func Flatten3[T any](o Option[Option[Option[Option[T]]]]) Option[T] {
+	return Flatten(Flatten2(o))
+}
+
+func Coalesce[T any](options ...Option[T]) Option[T] {
+	for _, o := range options {
+		if o.valid {
+			return o
+		}
+	}
+	return Option[T]{}
+}
+
+func CoalesceFunc[T any](producers ...func() Option[T]) Option[T] {
+	for _, f := range producers {
+		if o := f(); o.valid {
+			return o
+		}
+	}
+	return Option[T]{}
+}
+
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+func Zip[A, B any](a Option[A], b Option[B]) Option[Pair[A, B]] {
+	if a.valid && b.valid {
+		return Option[Pair[A, B]]{valid: true, value: Pair[A, B]{First: a.value, Second: b.value}}
+	} else {
+		return Option[Pair[A, B]]{}
+	}
+}
+
+func ZipWith[A, B, U any](a Option[A], b Option[B], f func(A, B) U) Option[U] {
+	if a.valid && b.valid {
+		return Option[U]{valid: true, value: f(a.value, b.value)}
+	} else {
+		return Option[U]{}
+	}
+}
+
+func Unzip[A, B any](o Option[Pair[A, B]]) (Option[A], Option[B]) {
+	if o.valid {
+		return Option[A]{valid: true, value: o.value.First}, Option[B]{valid: true, value: o.value.Second}
+	} else {
+		return Option[A]{}, Option[B]{}
+	}
+}
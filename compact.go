@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+var _ OptionOf[*int] = Compact[int]{}
+
+type Compact[T any] struct {
+	_   hostLayout
+	ptr *T
+}
+
+func SomeCompact[T any](ptr *T) Compact[T] {
+	return Compact[T]{ptr: ptr}
+}
+
+func ToCompact[T any](o Option[*T]) Compact[T] {
+	if o.valid {
+		return Compact[T]{ptr: o.value}
+	} else {
+		return Compact[T]{}
+	}
+}
+
+func (c Compact[T]) IsSome() bool {
+	return c.ptr != nil
+}
+
+func (c Compact[T]) IsNone() bool {
+	return c.ptr == nil
+}
+
+func (c Compact[T]) Get() (*T, bool) {
+	return c.ptr, c.ptr != nil
+}
+
+func (c Compact[T]) Option() Option[*T] {
+	return FromLookup(c.ptr, c.ptr != nil)
+}
+
+func (c Compact[T]) String() string {
+	return c.Option().String()
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestCompactSize(t *testing.T) {
+	ptr := unsafe.Sizeof((*int)(nil))
+	if size := unsafe.Sizeof(Compact[int]{}); size != ptr {
+		t.Errorf("unsafe.Sizeof(Compact[int]{}) = %d, want %d", size, ptr)
+	}
+	if size := unsafe.Sizeof(Compact[[64]byte]{}); size != ptr {
+		t.Errorf("unsafe.Sizeof(Compact[[64]byte]{}) = %d, want %d", size, ptr)
+	}
+}
+
+func BenchmarkCompact(b *testing.B) {
+	values := make([]int, 1024)
+	compact := make([]Compact[int], len(values))
+	options := make([]Option[*int], len(values))
+	for i := range values {
+		values[i] = i
+		if i%3 != 0 {
+			compact[i] = SomeCompact(&values[i])
+			options[i] = Some(&values[i])
+		}
+	}
+	b.Run("Compact", func(b *testing.B) {
+		for b.Loop() {
+			sum := 0
+			for _, c := range compact {
+				if p, ok := c.Get(); ok {
+					sum += *p
+				}
+			}
+			_ = sum
+		}
+		b.ReportMetric(float64(unsafe.Sizeof(compact[0])), "bytes/value")
+	})
+	b.Run("Option", func(b *testing.B) {
+		for b.Loop() {
+			sum := 0
+			for _, o := range options {
+				if p, ok := o.Get(); ok {
+					sum += *p
+				}
+			}
+			_ = sum
+		}
+		b.ReportMetric(float64(unsafe.Sizeof(options[0])), "bytes/value")
+	})
+}
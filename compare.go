@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "cmp"
+
+func Equal[T comparable](a, b Option[T]) bool {
+	return a.valid == b.valid && (!a.valid || a.value == b.value)
+}
+
+func EqualFunc[T, U any](a Option[T], b Option[U], eq func(T, U) bool) bool {
+	return a.valid == b.valid && (!a.valid || eq(a.value, b.value))
+}
+
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+func CompareFunc[T, U any](a Option[T], b Option[U], cmp func(T, U) int) int {
+	switch {
+	case a.valid && b.valid:
+		return cmp(a.value, b.value)
+	case a.valid:
+		return +1
+	case b.valid:
+		return -1
+	default:
+		return 0
+	}
+}
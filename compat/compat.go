@@ -0,0 +1,6 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate go run ../cmd/optiongen -package compat -interop -import time -o compat_gen.go String=string Int=int Bool=bool Time=time.Time Float64=float64
+
+package compat
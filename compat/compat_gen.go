@@ -0,0 +1,1818 @@
+// Code generated by optiongen; DO NOT EDIT.
+
+package compat
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"github.com/antoniszymanski/option-go"
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+	"time"
+)
+
+type OptionString struct {
+	valid bool
+	value string
+}
+
+func SomeString(value string) OptionString {
+	return OptionString{valid: true, value: value}
+}
+
+func NoneString() OptionString {
+	return OptionString{}
+}
+
+func NewOptionString(o option.Option[string]) OptionString {
+	return OptionString{valid: o.IsSome(), value: o.UnwrapOrZero()}
+}
+
+func (o OptionString) Option() option.Option[string] {
+	if o.valid {
+		return option.Some(o.value)
+	} else {
+		return option.None[string]()
+	}
+}
+
+func (o OptionString) IsSome() bool {
+	return o.valid
+}
+
+func (o OptionString) IsNone() bool {
+	return !o.valid
+}
+
+func (o OptionString) Get() (string, bool) {
+	return o.value, o.valid
+}
+
+func (o OptionString) Expect(msg string) string {
+	if o.valid {
+		return o.value
+	} else {
+		panic(msg)
+	}
+}
+
+func (o OptionString) Unwrap() string {
+	if o.valid {
+		return o.value
+	} else {
+		panic("called Unwrap on a None value")
+	}
+}
+
+func (o OptionString) UnwrapOr(fallback string) string {
+	if o.valid {
+		return o.value
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionString) UnwrapOrZero() string {
+	return o.value
+}
+
+func (o OptionString) UnwrapOrElse(f func() string) string {
+	if o.valid {
+		return o.value
+	} else {
+		return f()
+	}
+}
+
+func (o OptionString) IsSomeAnd(f func(string) bool) bool {
+	return o.valid && f(o.value)
+}
+
+func (o OptionString) IsNoneOr(f func(string) bool) bool {
+	return !o.valid || f(o.value)
+}
+
+func (o OptionString) AsSlice() []string {
+	if o.valid {
+		return []string{o.value}
+	} else {
+		return nil
+	}
+}
+
+func (o *OptionString) TakeOk() (string, bool) {
+	value, ok := o.value, o.valid
+	*o = OptionString{}
+	return value, ok
+}
+
+func (o *OptionString) Set(value string) {
+	o.valid = true
+	o.value = value
+}
+
+func (o *OptionString) SetNone() {
+	var zero string
+	o.valid = false
+	o.value = zero
+}
+
+func (o *OptionString) Reset() {
+	o.SetNone()
+}
+
+func (o *OptionString) Insert(value string) *string {
+	*o = OptionString{valid: true, value: value}
+	return &o.value
+}
+
+func (o *OptionString) GetOrInsert(value string) *string {
+	if !o.valid {
+		*o = OptionString{valid: true, value: value}
+	}
+	return &o.value
+}
+
+func (o *OptionString) GetOrInsertWith(f func() string) *string {
+	if !o.valid {
+		*o = OptionString{valid: true, value: f()}
+	}
+	return &o.value
+}
+
+func (o *OptionString) Replace(value string) OptionString {
+	old := *o
+	*o = OptionString{valid: true, value: value}
+	return old
+}
+
+func (o *OptionString) Take() OptionString {
+	old := *o
+	*o = OptionString{}
+	return old
+}
+
+func (o OptionString) Ptr() *string {
+	if o.valid {
+		return &o.value
+	} else {
+		return nil
+	}
+}
+
+func (o OptionString) Filter(predicate func(*string) bool) OptionString {
+	if o.valid && predicate(&o.value) {
+		return o
+	} else {
+		return OptionString{}
+	}
+}
+
+func (o OptionString) Inspect(f func(*string)) OptionString {
+	if o.valid {
+		f(&o.value)
+	}
+	return o
+}
+
+func (o OptionString) IfSome(f func(string)) {
+	if o.valid {
+		f(o.value)
+	}
+}
+
+func (o OptionString) IfNone(f func()) {
+	if !o.valid {
+		f()
+	}
+}
+
+func (o OptionString) Match(some func(string), none func()) {
+	if o.valid {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
+func (o OptionString) Map(f func(string) string) OptionString {
+	if o.valid {
+		return OptionString{valid: true, value: f(o.value)}
+	} else {
+		return OptionString{}
+	}
+}
+
+func (o OptionString) MapOr(fallback string, f func(string) string) string {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionString) MapOrElse(fallback func() string, f func(string) string) string {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback()
+	}
+}
+
+func (o OptionString) And(other OptionString) OptionString {
+	if o.valid {
+		return other
+	} else {
+		return OptionString{}
+	}
+}
+
+func (o OptionString) Or(other OptionString) OptionString {
+	if o.valid {
+		return o
+	} else {
+		return other
+	}
+}
+
+func (o OptionString) Xor(other OptionString) OptionString {
+	switch {
+	case o.valid && !other.valid:
+		return o
+	case !o.valid && other.valid:
+		return other
+	default:
+		return OptionString{}
+	}
+}
+
+func (o OptionString) AndThen(f func(string) OptionString) OptionString {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return OptionString{}
+	}
+}
+
+func (o OptionString) OrElse(f func() OptionString) OptionString {
+	if o.valid {
+		return o
+	} else {
+		return f()
+	}
+}
+
+func (o OptionString) String() string {
+	if o.valid {
+		return fmt.Sprintf("Some(%v)", o.value)
+	} else {
+		return "None"
+	}
+}
+
+func (o OptionString) GoString() string {
+	if o.valid {
+		return fmt.Sprintf("option.Some(%#v)", o.value)
+	} else {
+		return "option.None[string]()"
+	}
+}
+
+func (o OptionString) MarshalJSON() ([]byte, error) {
+	return o.Option().MarshalJSON()
+}
+
+func (o *OptionString) UnmarshalJSON(data []byte) error {
+	var opt option.Option[string]
+	err := opt.UnmarshalJSON(data)
+	*o = NewOptionString(opt)
+	return err
+}
+
+func (o OptionString) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return o.Option().MarshalJSONTo(enc)
+}
+
+func (o *OptionString) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var opt option.Option[string]
+	err := opt.UnmarshalJSONFrom(dec)
+	*o = NewOptionString(opt)
+	return err
+}
+
+var (
+	_ json.Marshaler       = OptionString{}
+	_ json.Unmarshaler     = &OptionString{}
+	_ json.MarshalerTo     = OptionString{}
+	_ json.UnmarshalerFrom = &OptionString{}
+)
+
+func (o *OptionString) Scan(src any) error {
+	var opt option.Option[string]
+	err := opt.Scan(src)
+	*o = NewOptionString(opt)
+	return err
+}
+
+func (o OptionString) Value() (driver.Value, error) {
+	return o.Option().Value()
+}
+
+func (o OptionString) MarshalText() ([]byte, error) {
+	return o.Option().MarshalText()
+}
+
+func (o *OptionString) UnmarshalText(text []byte) error {
+	var opt option.Option[string]
+	err := opt.UnmarshalText(text)
+	*o = NewOptionString(opt)
+	return err
+}
+
+func (o OptionString) MarshalBinary() ([]byte, error) {
+	return o.Option().MarshalBinary()
+}
+
+func (o *OptionString) UnmarshalBinary(data []byte) error {
+	var opt option.Option[string]
+	err := opt.UnmarshalBinary(data)
+	*o = NewOptionString(opt)
+	return err
+}
+
+func (o OptionString) MarshalYAML() (any, error) {
+	return o.Option().MarshalYAML()
+}
+
+func (o *OptionString) UnmarshalYAML(unmarshal func(any) error) error {
+	var opt option.Option[string]
+	err := opt.UnmarshalYAML(unmarshal)
+	*o = NewOptionString(opt)
+	return err
+}
+
+func (o OptionString) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return o.Option().MarshalXML(e, start)
+}
+
+func (o *OptionString) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var opt option.Option[string]
+	err := opt.UnmarshalXML(d, start)
+	*o = NewOptionString(opt)
+	return err
+}
+
+func (o OptionString) IsZero() bool {
+	if !o.valid {
+		return true
+	}
+	if i, ok := any(o.value).(interface{ IsZero() bool }); ok {
+		return i.IsZero()
+	}
+	return false
+}
+
+type OptionInt struct {
+	valid bool
+	value int
+}
+
+func SomeInt(value int) OptionInt {
+	return OptionInt{valid: true, value: value}
+}
+
+func NoneInt() OptionInt {
+	return OptionInt{}
+}
+
+func NewOptionInt(o option.Option[int]) OptionInt {
+	return OptionInt{valid: o.IsSome(), value: o.UnwrapOrZero()}
+}
+
+func (o OptionInt) Option() option.Option[int] {
+	if o.valid {
+		return option.Some(o.value)
+	} else {
+		return option.None[int]()
+	}
+}
+
+func (o OptionInt) IsSome() bool {
+	return o.valid
+}
+
+func (o OptionInt) IsNone() bool {
+	return !o.valid
+}
+
+func (o OptionInt) Get() (int, bool) {
+	return o.value, o.valid
+}
+
+func (o OptionInt) Expect(msg string) int {
+	if o.valid {
+		return o.value
+	} else {
+		panic(msg)
+	}
+}
+
+func (o OptionInt) Unwrap() int {
+	if o.valid {
+		return o.value
+	} else {
+		panic("called Unwrap on a None value")
+	}
+}
+
+func (o OptionInt) UnwrapOr(fallback int) int {
+	if o.valid {
+		return o.value
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionInt) UnwrapOrZero() int {
+	return o.value
+}
+
+func (o OptionInt) UnwrapOrElse(f func() int) int {
+	if o.valid {
+		return o.value
+	} else {
+		return f()
+	}
+}
+
+func (o OptionInt) IsSomeAnd(f func(int) bool) bool {
+	return o.valid && f(o.value)
+}
+
+func (o OptionInt) IsNoneOr(f func(int) bool) bool {
+	return !o.valid || f(o.value)
+}
+
+func (o OptionInt) AsSlice() []int {
+	if o.valid {
+		return []int{o.value}
+	} else {
+		return nil
+	}
+}
+
+func (o *OptionInt) TakeOk() (int, bool) {
+	value, ok := o.value, o.valid
+	*o = OptionInt{}
+	return value, ok
+}
+
+func (o *OptionInt) Set(value int) {
+	o.valid = true
+	o.value = value
+}
+
+func (o *OptionInt) SetNone() {
+	var zero int
+	o.valid = false
+	o.value = zero
+}
+
+func (o *OptionInt) Reset() {
+	o.SetNone()
+}
+
+func (o *OptionInt) Insert(value int) *int {
+	*o = OptionInt{valid: true, value: value}
+	return &o.value
+}
+
+func (o *OptionInt) GetOrInsert(value int) *int {
+	if !o.valid {
+		*o = OptionInt{valid: true, value: value}
+	}
+	return &o.value
+}
+
+func (o *OptionInt) GetOrInsertWith(f func() int) *int {
+	if !o.valid {
+		*o = OptionInt{valid: true, value: f()}
+	}
+	return &o.value
+}
+
+func (o *OptionInt) Replace(value int) OptionInt {
+	old := *o
+	*o = OptionInt{valid: true, value: value}
+	return old
+}
+
+func (o *OptionInt) Take() OptionInt {
+	old := *o
+	*o = OptionInt{}
+	return old
+}
+
+func (o OptionInt) Ptr() *int {
+	if o.valid {
+		return &o.value
+	} else {
+		return nil
+	}
+}
+
+func (o OptionInt) Filter(predicate func(*int) bool) OptionInt {
+	if o.valid && predicate(&o.value) {
+		return o
+	} else {
+		return OptionInt{}
+	}
+}
+
+func (o OptionInt) Inspect(f func(*int)) OptionInt {
+	if o.valid {
+		f(&o.value)
+	}
+	return o
+}
+
+func (o OptionInt) IfSome(f func(int)) {
+	if o.valid {
+		f(o.value)
+	}
+}
+
+func (o OptionInt) IfNone(f func()) {
+	if !o.valid {
+		f()
+	}
+}
+
+func (o OptionInt) Match(some func(int), none func()) {
+	if o.valid {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
+func (o OptionInt) Map(f func(int) int) OptionInt {
+	if o.valid {
+		return OptionInt{valid: true, value: f(o.value)}
+	} else {
+		return OptionInt{}
+	}
+}
+
+func (o OptionInt) MapOr(fallback int, f func(int) int) int {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionInt) MapOrElse(fallback func() int, f func(int) int) int {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback()
+	}
+}
+
+func (o OptionInt) And(other OptionInt) OptionInt {
+	if o.valid {
+		return other
+	} else {
+		return OptionInt{}
+	}
+}
+
+func (o OptionInt) Or(other OptionInt) OptionInt {
+	if o.valid {
+		return o
+	} else {
+		return other
+	}
+}
+
+func (o OptionInt) Xor(other OptionInt) OptionInt {
+	switch {
+	case o.valid && !other.valid:
+		return o
+	case !o.valid && other.valid:
+		return other
+	default:
+		return OptionInt{}
+	}
+}
+
+func (o OptionInt) AndThen(f func(int) OptionInt) OptionInt {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return OptionInt{}
+	}
+}
+
+func (o OptionInt) OrElse(f func() OptionInt) OptionInt {
+	if o.valid {
+		return o
+	} else {
+		return f()
+	}
+}
+
+func (o OptionInt) String() string {
+	if o.valid {
+		return fmt.Sprintf("Some(%v)", o.value)
+	} else {
+		return "None"
+	}
+}
+
+func (o OptionInt) GoString() string {
+	if o.valid {
+		return fmt.Sprintf("option.Some(%#v)", o.value)
+	} else {
+		return "option.None[int]()"
+	}
+}
+
+func (o OptionInt) MarshalJSON() ([]byte, error) {
+	return o.Option().MarshalJSON()
+}
+
+func (o *OptionInt) UnmarshalJSON(data []byte) error {
+	var opt option.Option[int]
+	err := opt.UnmarshalJSON(data)
+	*o = NewOptionInt(opt)
+	return err
+}
+
+func (o OptionInt) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return o.Option().MarshalJSONTo(enc)
+}
+
+func (o *OptionInt) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var opt option.Option[int]
+	err := opt.UnmarshalJSONFrom(dec)
+	*o = NewOptionInt(opt)
+	return err
+}
+
+var (
+	_ json.Marshaler       = OptionInt{}
+	_ json.Unmarshaler     = &OptionInt{}
+	_ json.MarshalerTo     = OptionInt{}
+	_ json.UnmarshalerFrom = &OptionInt{}
+)
+
+func (o *OptionInt) Scan(src any) error {
+	var opt option.Option[int]
+	err := opt.Scan(src)
+	*o = NewOptionInt(opt)
+	return err
+}
+
+func (o OptionInt) Value() (driver.Value, error) {
+	return o.Option().Value()
+}
+
+func (o OptionInt) MarshalText() ([]byte, error) {
+	return o.Option().MarshalText()
+}
+
+func (o *OptionInt) UnmarshalText(text []byte) error {
+	var opt option.Option[int]
+	err := opt.UnmarshalText(text)
+	*o = NewOptionInt(opt)
+	return err
+}
+
+func (o OptionInt) MarshalBinary() ([]byte, error) {
+	return o.Option().MarshalBinary()
+}
+
+func (o *OptionInt) UnmarshalBinary(data []byte) error {
+	var opt option.Option[int]
+	err := opt.UnmarshalBinary(data)
+	*o = NewOptionInt(opt)
+	return err
+}
+
+func (o OptionInt) MarshalYAML() (any, error) {
+	return o.Option().MarshalYAML()
+}
+
+func (o *OptionInt) UnmarshalYAML(unmarshal func(any) error) error {
+	var opt option.Option[int]
+	err := opt.UnmarshalYAML(unmarshal)
+	*o = NewOptionInt(opt)
+	return err
+}
+
+func (o OptionInt) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return o.Option().MarshalXML(e, start)
+}
+
+func (o *OptionInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var opt option.Option[int]
+	err := opt.UnmarshalXML(d, start)
+	*o = NewOptionInt(opt)
+	return err
+}
+
+func (o OptionInt) IsZero() bool {
+	if !o.valid {
+		return true
+	}
+	if i, ok := any(o.value).(interface{ IsZero() bool }); ok {
+		return i.IsZero()
+	}
+	return false
+}
+
+type OptionBool struct {
+	valid bool
+	value bool
+}
+
+func SomeBool(value bool) OptionBool {
+	return OptionBool{valid: true, value: value}
+}
+
+func NoneBool() OptionBool {
+	return OptionBool{}
+}
+
+func NewOptionBool(o option.Option[bool]) OptionBool {
+	return OptionBool{valid: o.IsSome(), value: o.UnwrapOrZero()}
+}
+
+func (o OptionBool) Option() option.Option[bool] {
+	if o.valid {
+		return option.Some(o.value)
+	} else {
+		return option.None[bool]()
+	}
+}
+
+func (o OptionBool) IsSome() bool {
+	return o.valid
+}
+
+func (o OptionBool) IsNone() bool {
+	return !o.valid
+}
+
+func (o OptionBool) Get() (bool, bool) {
+	return o.value, o.valid
+}
+
+func (o OptionBool) Expect(msg string) bool {
+	if o.valid {
+		return o.value
+	} else {
+		panic(msg)
+	}
+}
+
+func (o OptionBool) Unwrap() bool {
+	if o.valid {
+		return o.value
+	} else {
+		panic("called Unwrap on a None value")
+	}
+}
+
+func (o OptionBool) UnwrapOr(fallback bool) bool {
+	if o.valid {
+		return o.value
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionBool) UnwrapOrZero() bool {
+	return o.value
+}
+
+func (o OptionBool) UnwrapOrElse(f func() bool) bool {
+	if o.valid {
+		return o.value
+	} else {
+		return f()
+	}
+}
+
+func (o OptionBool) IsSomeAnd(f func(bool) bool) bool {
+	return o.valid && f(o.value)
+}
+
+func (o OptionBool) IsNoneOr(f func(bool) bool) bool {
+	return !o.valid || f(o.value)
+}
+
+func (o OptionBool) AsSlice() []bool {
+	if o.valid {
+		return []bool{o.value}
+	} else {
+		return nil
+	}
+}
+
+func (o *OptionBool) TakeOk() (bool, bool) {
+	value, ok := o.value, o.valid
+	*o = OptionBool{}
+	return value, ok
+}
+
+func (o *OptionBool) Set(value bool) {
+	o.valid = true
+	o.value = value
+}
+
+func (o *OptionBool) SetNone() {
+	var zero bool
+	o.valid = false
+	o.value = zero
+}
+
+func (o *OptionBool) Reset() {
+	o.SetNone()
+}
+
+func (o *OptionBool) Insert(value bool) *bool {
+	*o = OptionBool{valid: true, value: value}
+	return &o.value
+}
+
+func (o *OptionBool) GetOrInsert(value bool) *bool {
+	if !o.valid {
+		*o = OptionBool{valid: true, value: value}
+	}
+	return &o.value
+}
+
+func (o *OptionBool) GetOrInsertWith(f func() bool) *bool {
+	if !o.valid {
+		*o = OptionBool{valid: true, value: f()}
+	}
+	return &o.value
+}
+
+func (o *OptionBool) Replace(value bool) OptionBool {
+	old := *o
+	*o = OptionBool{valid: true, value: value}
+	return old
+}
+
+func (o *OptionBool) Take() OptionBool {
+	old := *o
+	*o = OptionBool{}
+	return old
+}
+
+func (o OptionBool) Ptr() *bool {
+	if o.valid {
+		return &o.value
+	} else {
+		return nil
+	}
+}
+
+func (o OptionBool) Filter(predicate func(*bool) bool) OptionBool {
+	if o.valid && predicate(&o.value) {
+		return o
+	} else {
+		return OptionBool{}
+	}
+}
+
+func (o OptionBool) Inspect(f func(*bool)) OptionBool {
+	if o.valid {
+		f(&o.value)
+	}
+	return o
+}
+
+func (o OptionBool) IfSome(f func(bool)) {
+	if o.valid {
+		f(o.value)
+	}
+}
+
+func (o OptionBool) IfNone(f func()) {
+	if !o.valid {
+		f()
+	}
+}
+
+func (o OptionBool) Match(some func(bool), none func()) {
+	if o.valid {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
+func (o OptionBool) Map(f func(bool) bool) OptionBool {
+	if o.valid {
+		return OptionBool{valid: true, value: f(o.value)}
+	} else {
+		return OptionBool{}
+	}
+}
+
+func (o OptionBool) MapOr(fallback bool, f func(bool) bool) bool {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionBool) MapOrElse(fallback func() bool, f func(bool) bool) bool {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback()
+	}
+}
+
+func (o OptionBool) And(other OptionBool) OptionBool {
+	if o.valid {
+		return other
+	} else {
+		return OptionBool{}
+	}
+}
+
+func (o OptionBool) Or(other OptionBool) OptionBool {
+	if o.valid {
+		return o
+	} else {
+		return other
+	}
+}
+
+func (o OptionBool) Xor(other OptionBool) OptionBool {
+	switch {
+	case o.valid && !other.valid:
+		return o
+	case !o.valid && other.valid:
+		return other
+	default:
+		return OptionBool{}
+	}
+}
+
+func (o OptionBool) AndThen(f func(bool) OptionBool) OptionBool {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return OptionBool{}
+	}
+}
+
+func (o OptionBool) OrElse(f func() OptionBool) OptionBool {
+	if o.valid {
+		return o
+	} else {
+		return f()
+	}
+}
+
+func (o OptionBool) String() string {
+	if o.valid {
+		return fmt.Sprintf("Some(%v)", o.value)
+	} else {
+		return "None"
+	}
+}
+
+func (o OptionBool) GoString() string {
+	if o.valid {
+		return fmt.Sprintf("option.Some(%#v)", o.value)
+	} else {
+		return "option.None[bool]()"
+	}
+}
+
+func (o OptionBool) MarshalJSON() ([]byte, error) {
+	return o.Option().MarshalJSON()
+}
+
+func (o *OptionBool) UnmarshalJSON(data []byte) error {
+	var opt option.Option[bool]
+	err := opt.UnmarshalJSON(data)
+	*o = NewOptionBool(opt)
+	return err
+}
+
+func (o OptionBool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return o.Option().MarshalJSONTo(enc)
+}
+
+func (o *OptionBool) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var opt option.Option[bool]
+	err := opt.UnmarshalJSONFrom(dec)
+	*o = NewOptionBool(opt)
+	return err
+}
+
+var (
+	_ json.Marshaler       = OptionBool{}
+	_ json.Unmarshaler     = &OptionBool{}
+	_ json.MarshalerTo     = OptionBool{}
+	_ json.UnmarshalerFrom = &OptionBool{}
+)
+
+func (o *OptionBool) Scan(src any) error {
+	var opt option.Option[bool]
+	err := opt.Scan(src)
+	*o = NewOptionBool(opt)
+	return err
+}
+
+func (o OptionBool) Value() (driver.Value, error) {
+	return o.Option().Value()
+}
+
+func (o OptionBool) MarshalText() ([]byte, error) {
+	return o.Option().MarshalText()
+}
+
+func (o *OptionBool) UnmarshalText(text []byte) error {
+	var opt option.Option[bool]
+	err := opt.UnmarshalText(text)
+	*o = NewOptionBool(opt)
+	return err
+}
+
+func (o OptionBool) MarshalBinary() ([]byte, error) {
+	return o.Option().MarshalBinary()
+}
+
+func (o *OptionBool) UnmarshalBinary(data []byte) error {
+	var opt option.Option[bool]
+	err := opt.UnmarshalBinary(data)
+	*o = NewOptionBool(opt)
+	return err
+}
+
+func (o OptionBool) MarshalYAML() (any, error) {
+	return o.Option().MarshalYAML()
+}
+
+func (o *OptionBool) UnmarshalYAML(unmarshal func(any) error) error {
+	var opt option.Option[bool]
+	err := opt.UnmarshalYAML(unmarshal)
+	*o = NewOptionBool(opt)
+	return err
+}
+
+func (o OptionBool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return o.Option().MarshalXML(e, start)
+}
+
+func (o *OptionBool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var opt option.Option[bool]
+	err := opt.UnmarshalXML(d, start)
+	*o = NewOptionBool(opt)
+	return err
+}
+
+func (o OptionBool) IsZero() bool {
+	if !o.valid {
+		return true
+	}
+	if i, ok := any(o.value).(interface{ IsZero() bool }); ok {
+		return i.IsZero()
+	}
+	return false
+}
+
+type OptionTime struct {
+	valid bool
+	value time.Time
+}
+
+func SomeTime(value time.Time) OptionTime {
+	return OptionTime{valid: true, value: value}
+}
+
+func NoneTime() OptionTime {
+	return OptionTime{}
+}
+
+func NewOptionTime(o option.Option[time.Time]) OptionTime {
+	return OptionTime{valid: o.IsSome(), value: o.UnwrapOrZero()}
+}
+
+func (o OptionTime) Option() option.Option[time.Time] {
+	if o.valid {
+		return option.Some(o.value)
+	} else {
+		return option.None[time.Time]()
+	}
+}
+
+func (o OptionTime) IsSome() bool {
+	return o.valid
+}
+
+func (o OptionTime) IsNone() bool {
+	return !o.valid
+}
+
+func (o OptionTime) Get() (time.Time, bool) {
+	return o.value, o.valid
+}
+
+func (o OptionTime) Expect(msg string) time.Time {
+	if o.valid {
+		return o.value
+	} else {
+		panic(msg)
+	}
+}
+
+func (o OptionTime) Unwrap() time.Time {
+	if o.valid {
+		return o.value
+	} else {
+		panic("called Unwrap on a None value")
+	}
+}
+
+func (o OptionTime) UnwrapOr(fallback time.Time) time.Time {
+	if o.valid {
+		return o.value
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionTime) UnwrapOrZero() time.Time {
+	return o.value
+}
+
+func (o OptionTime) UnwrapOrElse(f func() time.Time) time.Time {
+	if o.valid {
+		return o.value
+	} else {
+		return f()
+	}
+}
+
+func (o OptionTime) IsSomeAnd(f func(time.Time) bool) bool {
+	return o.valid && f(o.value)
+}
+
+func (o OptionTime) IsNoneOr(f func(time.Time) bool) bool {
+	return !o.valid || f(o.value)
+}
+
+func (o OptionTime) AsSlice() []time.Time {
+	if o.valid {
+		return []time.Time{o.value}
+	} else {
+		return nil
+	}
+}
+
+func (o *OptionTime) TakeOk() (time.Time, bool) {
+	value, ok := o.value, o.valid
+	*o = OptionTime{}
+	return value, ok
+}
+
+func (o *OptionTime) Set(value time.Time) {
+	o.valid = true
+	o.value = value
+}
+
+func (o *OptionTime) SetNone() {
+	var zero time.Time
+	o.valid = false
+	o.value = zero
+}
+
+func (o *OptionTime) Reset() {
+	o.SetNone()
+}
+
+func (o *OptionTime) Insert(value time.Time) *time.Time {
+	*o = OptionTime{valid: true, value: value}
+	return &o.value
+}
+
+func (o *OptionTime) GetOrInsert(value time.Time) *time.Time {
+	if !o.valid {
+		*o = OptionTime{valid: true, value: value}
+	}
+	return &o.value
+}
+
+func (o *OptionTime) GetOrInsertWith(f func() time.Time) *time.Time {
+	if !o.valid {
+		*o = OptionTime{valid: true, value: f()}
+	}
+	return &o.value
+}
+
+func (o *OptionTime) Replace(value time.Time) OptionTime {
+	old := *o
+	*o = OptionTime{valid: true, value: value}
+	return old
+}
+
+func (o *OptionTime) Take() OptionTime {
+	old := *o
+	*o = OptionTime{}
+	return old
+}
+
+func (o OptionTime) Ptr() *time.Time {
+	if o.valid {
+		return &o.value
+	} else {
+		return nil
+	}
+}
+
+func (o OptionTime) Filter(predicate func(*time.Time) bool) OptionTime {
+	if o.valid && predicate(&o.value) {
+		return o
+	} else {
+		return OptionTime{}
+	}
+}
+
+func (o OptionTime) Inspect(f func(*time.Time)) OptionTime {
+	if o.valid {
+		f(&o.value)
+	}
+	return o
+}
+
+func (o OptionTime) IfSome(f func(time.Time)) {
+	if o.valid {
+		f(o.value)
+	}
+}
+
+func (o OptionTime) IfNone(f func()) {
+	if !o.valid {
+		f()
+	}
+}
+
+func (o OptionTime) Match(some func(time.Time), none func()) {
+	if o.valid {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
+func (o OptionTime) Map(f func(time.Time) time.Time) OptionTime {
+	if o.valid {
+		return OptionTime{valid: true, value: f(o.value)}
+	} else {
+		return OptionTime{}
+	}
+}
+
+func (o OptionTime) MapOr(fallback time.Time, f func(time.Time) time.Time) time.Time {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionTime) MapOrElse(fallback func() time.Time, f func(time.Time) time.Time) time.Time {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback()
+	}
+}
+
+func (o OptionTime) And(other OptionTime) OptionTime {
+	if o.valid {
+		return other
+	} else {
+		return OptionTime{}
+	}
+}
+
+func (o OptionTime) Or(other OptionTime) OptionTime {
+	if o.valid {
+		return o
+	} else {
+		return other
+	}
+}
+
+func (o OptionTime) Xor(other OptionTime) OptionTime {
+	switch {
+	case o.valid && !other.valid:
+		return o
+	case !o.valid && other.valid:
+		return other
+	default:
+		return OptionTime{}
+	}
+}
+
+func (o OptionTime) AndThen(f func(time.Time) OptionTime) OptionTime {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return OptionTime{}
+	}
+}
+
+func (o OptionTime) OrElse(f func() OptionTime) OptionTime {
+	if o.valid {
+		return o
+	} else {
+		return f()
+	}
+}
+
+func (o OptionTime) String() string {
+	if o.valid {
+		return fmt.Sprintf("Some(%v)", o.value)
+	} else {
+		return "None"
+	}
+}
+
+func (o OptionTime) GoString() string {
+	if o.valid {
+		return fmt.Sprintf("option.Some(%#v)", o.value)
+	} else {
+		return "option.None[time.Time]()"
+	}
+}
+
+func (o OptionTime) MarshalJSON() ([]byte, error) {
+	return o.Option().MarshalJSON()
+}
+
+func (o *OptionTime) UnmarshalJSON(data []byte) error {
+	var opt option.Option[time.Time]
+	err := opt.UnmarshalJSON(data)
+	*o = NewOptionTime(opt)
+	return err
+}
+
+func (o OptionTime) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return o.Option().MarshalJSONTo(enc)
+}
+
+func (o *OptionTime) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var opt option.Option[time.Time]
+	err := opt.UnmarshalJSONFrom(dec)
+	*o = NewOptionTime(opt)
+	return err
+}
+
+var (
+	_ json.Marshaler       = OptionTime{}
+	_ json.Unmarshaler     = &OptionTime{}
+	_ json.MarshalerTo     = OptionTime{}
+	_ json.UnmarshalerFrom = &OptionTime{}
+)
+
+func (o *OptionTime) Scan(src any) error {
+	var opt option.Option[time.Time]
+	err := opt.Scan(src)
+	*o = NewOptionTime(opt)
+	return err
+}
+
+func (o OptionTime) Value() (driver.Value, error) {
+	return o.Option().Value()
+}
+
+func (o OptionTime) MarshalText() ([]byte, error) {
+	return o.Option().MarshalText()
+}
+
+func (o *OptionTime) UnmarshalText(text []byte) error {
+	var opt option.Option[time.Time]
+	err := opt.UnmarshalText(text)
+	*o = NewOptionTime(opt)
+	return err
+}
+
+func (o OptionTime) MarshalBinary() ([]byte, error) {
+	return o.Option().MarshalBinary()
+}
+
+func (o *OptionTime) UnmarshalBinary(data []byte) error {
+	var opt option.Option[time.Time]
+	err := opt.UnmarshalBinary(data)
+	*o = NewOptionTime(opt)
+	return err
+}
+
+func (o OptionTime) MarshalYAML() (any, error) {
+	return o.Option().MarshalYAML()
+}
+
+func (o *OptionTime) UnmarshalYAML(unmarshal func(any) error) error {
+	var opt option.Option[time.Time]
+	err := opt.UnmarshalYAML(unmarshal)
+	*o = NewOptionTime(opt)
+	return err
+}
+
+func (o OptionTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return o.Option().MarshalXML(e, start)
+}
+
+func (o *OptionTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var opt option.Option[time.Time]
+	err := opt.UnmarshalXML(d, start)
+	*o = NewOptionTime(opt)
+	return err
+}
+
+func (o OptionTime) IsZero() bool {
+	if !o.valid {
+		return true
+	}
+	if i, ok := any(o.value).(interface{ IsZero() bool }); ok {
+		return i.IsZero()
+	}
+	return false
+}
+
+type OptionFloat64 struct {
+	valid bool
+	value float64
+}
+
+func SomeFloat64(value float64) OptionFloat64 {
+	return OptionFloat64{valid: true, value: value}
+}
+
+func NoneFloat64() OptionFloat64 {
+	return OptionFloat64{}
+}
+
+func NewOptionFloat64(o option.Option[float64]) OptionFloat64 {
+	return OptionFloat64{valid: o.IsSome(), value: o.UnwrapOrZero()}
+}
+
+func (o OptionFloat64) Option() option.Option[float64] {
+	if o.valid {
+		return option.Some(o.value)
+	} else {
+		return option.None[float64]()
+	}
+}
+
+func (o OptionFloat64) IsSome() bool {
+	return o.valid
+}
+
+func (o OptionFloat64) IsNone() bool {
+	return !o.valid
+}
+
+func (o OptionFloat64) Get() (float64, bool) {
+	return o.value, o.valid
+}
+
+func (o OptionFloat64) Expect(msg string) float64 {
+	if o.valid {
+		return o.value
+	} else {
+		panic(msg)
+	}
+}
+
+func (o OptionFloat64) Unwrap() float64 {
+	if o.valid {
+		return o.value
+	} else {
+		panic("called Unwrap on a None value")
+	}
+}
+
+func (o OptionFloat64) UnwrapOr(fallback float64) float64 {
+	if o.valid {
+		return o.value
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionFloat64) UnwrapOrZero() float64 {
+	return o.value
+}
+
+func (o OptionFloat64) UnwrapOrElse(f func() float64) float64 {
+	if o.valid {
+		return o.value
+	} else {
+		return f()
+	}
+}
+
+func (o OptionFloat64) IsSomeAnd(f func(float64) bool) bool {
+	return o.valid && f(o.value)
+}
+
+func (o OptionFloat64) IsNoneOr(f func(float64) bool) bool {
+	return !o.valid || f(o.value)
+}
+
+func (o OptionFloat64) AsSlice() []float64 {
+	if o.valid {
+		return []float64{o.value}
+	} else {
+		return nil
+	}
+}
+
+func (o *OptionFloat64) TakeOk() (float64, bool) {
+	value, ok := o.value, o.valid
+	*o = OptionFloat64{}
+	return value, ok
+}
+
+func (o *OptionFloat64) Set(value float64) {
+	o.valid = true
+	o.value = value
+}
+
+func (o *OptionFloat64) SetNone() {
+	var zero float64
+	o.valid = false
+	o.value = zero
+}
+
+func (o *OptionFloat64) Reset() {
+	o.SetNone()
+}
+
+func (o *OptionFloat64) Insert(value float64) *float64 {
+	*o = OptionFloat64{valid: true, value: value}
+	return &o.value
+}
+
+func (o *OptionFloat64) GetOrInsert(value float64) *float64 {
+	if !o.valid {
+		*o = OptionFloat64{valid: true, value: value}
+	}
+	return &o.value
+}
+
+func (o *OptionFloat64) GetOrInsertWith(f func() float64) *float64 {
+	if !o.valid {
+		*o = OptionFloat64{valid: true, value: f()}
+	}
+	return &o.value
+}
+
+func (o *OptionFloat64) Replace(value float64) OptionFloat64 {
+	old := *o
+	*o = OptionFloat64{valid: true, value: value}
+	return old
+}
+
+func (o *OptionFloat64) Take() OptionFloat64 {
+	old := *o
+	*o = OptionFloat64{}
+	return old
+}
+
+func (o OptionFloat64) Ptr() *float64 {
+	if o.valid {
+		return &o.value
+	} else {
+		return nil
+	}
+}
+
+func (o OptionFloat64) Filter(predicate func(*float64) bool) OptionFloat64 {
+	if o.valid && predicate(&o.value) {
+		return o
+	} else {
+		return OptionFloat64{}
+	}
+}
+
+func (o OptionFloat64) Inspect(f func(*float64)) OptionFloat64 {
+	if o.valid {
+		f(&o.value)
+	}
+	return o
+}
+
+func (o OptionFloat64) IfSome(f func(float64)) {
+	if o.valid {
+		f(o.value)
+	}
+}
+
+func (o OptionFloat64) IfNone(f func()) {
+	if !o.valid {
+		f()
+	}
+}
+
+func (o OptionFloat64) Match(some func(float64), none func()) {
+	if o.valid {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
+func (o OptionFloat64) Map(f func(float64) float64) OptionFloat64 {
+	if o.valid {
+		return OptionFloat64{valid: true, value: f(o.value)}
+	} else {
+		return OptionFloat64{}
+	}
+}
+
+func (o OptionFloat64) MapOr(fallback float64, f func(float64) float64) float64 {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback
+	}
+}
+
+func (o OptionFloat64) MapOrElse(fallback func() float64, f func(float64) float64) float64 {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return fallback()
+	}
+}
+
+func (o OptionFloat64) And(other OptionFloat64) OptionFloat64 {
+	if o.valid {
+		return other
+	} else {
+		return OptionFloat64{}
+	}
+}
+
+func (o OptionFloat64) Or(other OptionFloat64) OptionFloat64 {
+	if o.valid {
+		return o
+	} else {
+		return other
+	}
+}
+
+func (o OptionFloat64) Xor(other OptionFloat64) OptionFloat64 {
+	switch {
+	case o.valid && !other.valid:
+		return o
+	case !o.valid && other.valid:
+		return other
+	default:
+		return OptionFloat64{}
+	}
+}
+
+func (o OptionFloat64) AndThen(f func(float64) OptionFloat64) OptionFloat64 {
+	if o.valid {
+		return f(o.value)
+	} else {
+		return OptionFloat64{}
+	}
+}
+
+func (o OptionFloat64) OrElse(f func() OptionFloat64) OptionFloat64 {
+	if o.valid {
+		return o
+	} else {
+		return f()
+	}
+}
+
+func (o OptionFloat64) String() string {
+	if o.valid {
+		return fmt.Sprintf("Some(%v)", o.value)
+	} else {
+		return "None"
+	}
+}
+
+func (o OptionFloat64) GoString() string {
+	if o.valid {
+		return fmt.Sprintf("option.Some(%#v)", o.value)
+	} else {
+		return "option.None[float64]()"
+	}
+}
+
+func (o OptionFloat64) MarshalJSON() ([]byte, error) {
+	return o.Option().MarshalJSON()
+}
+
+func (o *OptionFloat64) UnmarshalJSON(data []byte) error {
+	var opt option.Option[float64]
+	err := opt.UnmarshalJSON(data)
+	*o = NewOptionFloat64(opt)
+	return err
+}
+
+func (o OptionFloat64) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return o.Option().MarshalJSONTo(enc)
+}
+
+func (o *OptionFloat64) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	var opt option.Option[float64]
+	err := opt.UnmarshalJSONFrom(dec)
+	*o = NewOptionFloat64(opt)
+	return err
+}
+
+var (
+	_ json.Marshaler       = OptionFloat64{}
+	_ json.Unmarshaler     = &OptionFloat64{}
+	_ json.MarshalerTo     = OptionFloat64{}
+	_ json.UnmarshalerFrom = &OptionFloat64{}
+)
+
+func (o *OptionFloat64) Scan(src any) error {
+	var opt option.Option[float64]
+	err := opt.Scan(src)
+	*o = NewOptionFloat64(opt)
+	return err
+}
+
+func (o OptionFloat64) Value() (driver.Value, error) {
+	return o.Option().Value()
+}
+
+func (o OptionFloat64) MarshalText() ([]byte, error) {
+	return o.Option().MarshalText()
+}
+
+func (o *OptionFloat64) UnmarshalText(text []byte) error {
+	var opt option.Option[float64]
+	err := opt.UnmarshalText(text)
+	*o = NewOptionFloat64(opt)
+	return err
+}
+
+func (o OptionFloat64) MarshalBinary() ([]byte, error) {
+	return o.Option().MarshalBinary()
+}
+
+func (o *OptionFloat64) UnmarshalBinary(data []byte) error {
+	var opt option.Option[float64]
+	err := opt.UnmarshalBinary(data)
+	*o = NewOptionFloat64(opt)
+	return err
+}
+
+func (o OptionFloat64) MarshalYAML() (any, error) {
+	return o.Option().MarshalYAML()
+}
+
+func (o *OptionFloat64) UnmarshalYAML(unmarshal func(any) error) error {
+	var opt option.Option[float64]
+	err := opt.UnmarshalYAML(unmarshal)
+	*o = NewOptionFloat64(opt)
+	return err
+}
+
+func (o OptionFloat64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return o.Option().MarshalXML(e, start)
+}
+
+func (o *OptionFloat64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var opt option.Option[float64]
+	err := opt.UnmarshalXML(d, start)
+	*o = NewOptionFloat64(opt)
+	return err
+}
+
+func (o OptionFloat64) IsZero() bool {
+	if !o.valid {
+		return true
+	}
+	if i, ok := any(o.value).(interface{ IsZero() bool }); ok {
+		return i.IsZero()
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "context"
+
+func FromContext[T any](ctx context.Context, key any) Option[T] {
+	switch v := ctx.Value(key).(type) {
+	case Option[T]:
+		return v
+	case T:
+		return Option[T]{valid: true, value: v}
+	default:
+		return Option[T]{}
+	}
+}
+
+func IntoContext[T any](ctx context.Context, key any, o Option[T]) context.Context {
+	return context.WithValue(ctx, key, o)
+}
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+func Convert[T, U any](o Option[T], f func(T) (U, error)) (Option[U], error) {
+	if !o.valid {
+		return Option[U]{}, nil
+	}
+	value, err := f(o.value)
+	if err != nil {
+		return Option[U]{}, err
+	}
+	return Option[U]{valid: true, value: value}, nil
+}
+
+func NumericCast[T, U Number](o Option[T]) Option[U] {
+	if !o.valid {
+		return Option[U]{}
+	}
+	value := U(o.value)
+	if T(value) != o.value || (o.value < 0) != (value < 0) {
+		return Option[U]{}
+	}
+	return Option[U]{valid: true, value: value}
+}
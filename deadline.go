@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Deadlines struct {
+	mu        sync.Mutex
+	deadlines map[string]time.Time
+	changed   chan struct{}
+}
+
+func (d *Deadlines) Set(name string, deadline Option[time.Time]) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if deadline.valid {
+		if d.deadlines == nil {
+			d.deadlines = make(map[string]time.Time)
+		}
+		d.deadlines[name] = deadline.value
+	} else {
+		delete(d.deadlines, name)
+	}
+	if d.changed != nil {
+		close(d.changed)
+		d.changed = nil
+	}
+}
+
+func (d *Deadlines) Get(name string) Option[time.Time] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	deadline, ok := d.deadlines[name]
+	return Option[time.Time]{valid: ok, value: deadline}
+}
+
+func (d *Deadlines) Earliest() Option[time.Time] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, deadline := d.earliest()
+	return deadline
+}
+
+func (d *Deadlines) earliest() (string, Option[time.Time]) {
+	var name string
+	var earliest Option[time.Time]
+	for n, deadline := range d.deadlines {
+		if !earliest.valid || deadline.Before(earliest.value) {
+			name, earliest = n, Option[time.Time]{valid: true, value: deadline}
+		}
+	}
+	return name, earliest
+}
+
+func (d *Deadlines) Wait(ctx context.Context) (string, error) {
+	for {
+		d.mu.Lock()
+		name, deadline := d.earliest()
+		if d.changed == nil {
+			d.changed = make(chan struct{})
+		}
+		changed := d.changed
+		d.mu.Unlock()
+
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if deadline.valid {
+			timer = time.NewTimer(time.Until(deadline.value))
+			expired = timer.C
+		}
+		select {
+		case <-expired:
+			return name, nil
+		case <-changed:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return "", ctx.Err()
+		}
+	}
+}
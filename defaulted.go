@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+type Defaulter[T any] interface {
+	Default() T
+}
+
+type Defaulted[T any, D Defaulter[T]] struct {
+	_     hostLayout
+	value Option[T]
+}
+
+func (d Defaulted[T, D]) Get() T {
+	if d.value.valid {
+		return d.value.value
+	} else {
+		var def D
+		return def.Default()
+	}
+}
+
+func (d Defaulted[T, D]) IsSet() bool {
+	return d.value.valid
+}
+
+func (d Defaulted[T, D]) Option() Option[T] {
+	return d.value
+}
+
+func (d *Defaulted[T, D]) Set(value T) {
+	d.value = Option[T]{valid: true, value: value}
+}
+
+func (d *Defaulted[T, D]) Reset() {
+	d.value = Option[T]{}
+}
+
+func (d Defaulted[T, D]) String() string {
+	return Some(d.Get()).String()
+}
+
+var (
+	_ json.Marshaler       = Defaulted[int, Defaulter[int]]{}
+	_ json.Unmarshaler     = &Defaulted[int, Defaulter[int]]{}
+	_ json.MarshalerTo     = Defaulted[int, Defaulter[int]]{}
+	_ json.UnmarshalerFrom = &Defaulted[int, Defaulter[int]]{}
+)
+
+func (d Defaulted[T, D]) MarshalJSON() ([]byte, error) {
+	return Some(d.Get()).MarshalJSON()
+}
+
+func (d *Defaulted[T, D]) UnmarshalJSON(data []byte) error {
+	return d.value.UnmarshalJSON(data)
+}
+
+func (d Defaulted[T, D]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return Some(d.Get()).MarshalJSONTo(enc)
+}
+
+func (d *Defaulted[T, D]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return d.value.UnmarshalJSONFrom(dec)
+}
+
+func (d Defaulted[T, D]) IsZero() bool {
+	return !d.value.valid
+}
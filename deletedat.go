@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+type DeletedAt struct {
+	Option[time.Time]
+}
+
+var (
+	_ sql.Scanner   = &DeletedAt{}
+	_ driver.Valuer = DeletedAt{}
+)
+
+func (d DeletedAt) IsDeleted() bool {
+	return d.valid
+}
+
+func (d DeletedAt) IsLive() bool {
+	return !d.valid
+}
+
+func (d *DeletedAt) Delete(at time.Time) {
+	d.Option = Some(at)
+}
+
+func (d *DeletedAt) Restore() {
+	d.Option = Option[time.Time]{}
+}
+
+func LiveClause(column string) string {
+	return column + " IS NULL"
+}
+
+func DeletedClause(column string) string {
+	return column + " IS NOT NULL"
+}
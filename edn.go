@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_edn
+
+package option
+
+import (
+	"bytes"
+
+	"olympos.io/encoding/edn"
+)
+
+var (
+	_ edn.Marshaler   = Option[int]{}
+	_ edn.Unmarshaler = &Option[int]{}
+)
+
+func (o Option[T]) MarshalEDN() ([]byte, error) {
+	if o.valid {
+		return edn.Marshal(&o.value)
+	} else {
+		return []byte("nil"), nil
+	}
+}
+
+func (o *Option[T]) UnmarshalEDN(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "nil" {
+		*o = Option[T]{}
+		return nil
+	}
+	if err := edn.Unmarshal(data, &o.value); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	o.valid = true
+	return nil
+}
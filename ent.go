@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_ent
+
+package option
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+var _ ent.Mixin = SoftDeleteMixin{}
+
+const deletedAtColumn = "deleted_at"
+
+type skipSoftDeleteKey struct{}
+
+func SkipSoftDelete(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipSoftDeleteKey{}, true)
+}
+
+func skipSoftDelete(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipSoftDeleteKey{}).(bool)
+	return skip
+}
+
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time(deletedAtColumn).Optional().GoType(DeletedAt{}),
+	}
+}
+
+type softDeleteFilter interface {
+	WhereP(...func(*sql.Selector))
+}
+
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		ent.TraverseFunc(func(ctx context.Context, q ent.Query) error {
+			if skipSoftDelete(ctx) {
+				return nil
+			}
+			if f, ok := q.(softDeleteFilter); ok {
+				f.WhereP(sql.FieldIsNull(deletedAtColumn))
+			}
+			return nil
+		}),
+	}
+}
+
+type softDeleteMutation interface {
+	ent.Mutation
+	softDeleteFilter
+	SetOp(ent.Op)
+}
+
+type softDeleteClient interface {
+	Mutate(context.Context, ent.Mutation) (ent.Value, error)
+}
+
+func (SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if skipSoftDelete(ctx) || !m.Op().Is(ent.OpDelete|ent.OpDeleteOne) {
+					return next.Mutate(ctx, m)
+				}
+				mx, ok := m.(softDeleteMutation)
+				if !ok {
+					return nil, fmt.Errorf("option: unexpected mutation type %T", m)
+				}
+				// generated mutations return their package's concrete *Client
+				client, ok := clientOf(mx)
+				if !ok {
+					return nil, fmt.Errorf("option: mutation %T has no Client method", m)
+				}
+				mx.WhereP(sql.FieldIsNull(deletedAtColumn))
+				mx.SetOp(ent.OpUpdate)
+				if err := mx.SetField(deletedAtColumn, DeletedAt{Some(time.Now())}); err != nil {
+					return nil, err
+				}
+				return client.Mutate(ctx, mx)
+			})
+		},
+	}
+}
+
+func clientOf(m ent.Mutation) (softDeleteClient, bool) {
+	method := reflect.ValueOf(m).MethodByName("Client")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil, false
+	}
+	client, ok := method.Call(nil)[0].Interface().(softDeleteClient)
+	return client, ok
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type FallbackChain[T any] struct {
+	sources  []func(context.Context) (Option[T], error)
+	timeouts []time.Duration
+}
+
+func Fallback[T any](primary func(context.Context) (Option[T], error), fallbacks ...func(context.Context) (Option[T], error)) *FallbackChain[T] {
+	return &FallbackChain[T]{sources: append([]func(context.Context) (Option[T], error){primary}, fallbacks...)}
+}
+
+func (c *FallbackChain[T]) WithTimeouts(timeouts ...time.Duration) *FallbackChain[T] {
+	c.timeouts = timeouts
+	return c
+}
+
+func (c *FallbackChain[T]) Get(ctx context.Context) (Option[T], int, error) {
+	var errs []error
+	for i, source := range c.sources {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		o, err := c.try(ctx, i, source)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("option: fallback source %d: %w", i, err))
+			continue
+		}
+		if o.valid {
+			return o, i, nil
+		}
+	}
+	return Option[T]{}, -1, errors.Join(errs...)
+}
+
+func (c *FallbackChain[T]) try(ctx context.Context, i int, source func(context.Context) (Option[T], error)) (Option[T], error) {
+	if i < len(c.timeouts) && c.timeouts[i] > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeouts[i])
+		defer cancel()
+	}
+	return source(ctx)
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"slices"
+	"strings"
+)
+
+func Fill(template string, values map[string]Option[string]) (string, []string) {
+	var b strings.Builder
+	var unfilled []string
+	for {
+		start := strings.Index(template, "{{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(template[start+2:], "}}")
+		if end < 0 {
+			break
+		}
+		end += start + 4
+		b.WriteString(template[:start])
+		name := strings.TrimSpace(template[start+2 : end-2])
+		switch v, ok := values[name]; {
+		case !ok:
+			b.WriteString(template[start:end])
+		case v.valid:
+			b.WriteString(v.value)
+		case !slices.Contains(unfilled, name):
+			unfilled = append(unfilled, name)
+		}
+		template = template[end:]
+	}
+	b.WriteString(template)
+	return b.String(), unfilled
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"flag"
+	"reflect"
+	"strings"
+)
+
+var _ flag.Getter = Flag[int]{}
+
+type Flag[T any] struct {
+	option *Option[T]
+}
+
+func NewFlag[T any](o *Option[T]) Flag[T] {
+	return Flag[T]{option: o}
+}
+
+func FlagVar[T any](fs *flag.FlagSet, o *Option[T], name, usage string) {
+	fs.Var(Flag[T]{option: o}, name, usage)
+}
+
+func (f Flag[T]) String() string {
+	if f.option == nil || !f.option.valid {
+		return ""
+	}
+	text, err := marshalText(&f.option.value)
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+func (f Flag[T]) Set(s string) error {
+	var value T
+	if err := unmarshalText(&value, []byte(s)); err != nil {
+		return err
+	}
+	*f.option = Option[T]{valid: true, value: value}
+	return nil
+}
+
+func (f Flag[T]) Get() any {
+	if f.option == nil {
+		return Option[T]{}
+	}
+	return *f.option
+}
+
+func (f Flag[T]) Type() string {
+	t := reflect.TypeFor[T]()
+	if name := t.Name(); name != "" {
+		return strings.ToLower(name)
+	} else {
+		return t.String()
+	}
+}
+
+func (f Flag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
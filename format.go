@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"strconv"
+	"time"
+)
+
+func appendValue[T any](b []byte, p *T) ([]byte, bool) {
+	switch v := any(p).(type) {
+	case *string:
+		return append(b, *v...), true
+	case *bool:
+		return strconv.AppendBool(b, *v), true
+	case *int:
+		return strconv.AppendInt(b, int64(*v), 10), true
+	case *int8:
+		return strconv.AppendInt(b, int64(*v), 10), true
+	case *int16:
+		return strconv.AppendInt(b, int64(*v), 10), true
+	case *int32:
+		return strconv.AppendInt(b, int64(*v), 10), true
+	case *int64:
+		return strconv.AppendInt(b, *v, 10), true
+	case *uint:
+		return strconv.AppendUint(b, uint64(*v), 10), true
+	case *uint8:
+		return strconv.AppendUint(b, uint64(*v), 10), true
+	case *uint16:
+		return strconv.AppendUint(b, uint64(*v), 10), true
+	case *uint32:
+		return strconv.AppendUint(b, uint64(*v), 10), true
+	case *uint64:
+		return strconv.AppendUint(b, *v, 10), true
+	case *float32:
+		return strconv.AppendFloat(b, float64(*v), 'g', -1, 32), true
+	case *float64:
+		return strconv.AppendFloat(b, *v, 'g', -1, 64), true
+	case *time.Time:
+		return append(b, v.String()...), true
+	case *time.Duration:
+		return append(b, v.String()...), true
+	default:
+		return b, false
+	}
+}
+
+func appendGoValue[T any](b []byte, p *T) ([]byte, bool) {
+	switch v := any(p).(type) {
+	case *string:
+		return strconv.AppendQuote(b, *v), true
+	case *bool:
+		return strconv.AppendBool(b, *v), true
+	case *int:
+		return strconv.AppendInt(b, int64(*v), 10), true
+	case *int64:
+		return strconv.AppendInt(b, *v, 10), true
+	default:
+		return b, false
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "os"
+
+func FirstNonZero[T comparable](values ...T) Option[T] {
+	var zero T
+	for _, value := range values {
+		if value != zero {
+			return Some(value)
+		}
+	}
+	return Option[T]{}
+}
+
+func SomeIf[T any](cond bool, value T) Option[T] {
+	if cond {
+		return Option[T]{valid: true, value: value}
+	} else {
+		return Option[T]{}
+	}
+}
+
+func FromZero[T comparable](value T) Option[T] {
+	var zero T
+	if value != zero {
+		return Option[T]{valid: true, value: value}
+	} else {
+		return Option[T]{}
+	}
+}
+
+func NoneIf[T any](value T, pred func(T) bool) Option[T] {
+	if pred(value) {
+		return Option[T]{}
+	} else {
+		return Option[T]{valid: true, value: value}
+	}
+}
+
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr != nil {
+		return Option[T]{valid: true, value: *ptr}
+	} else {
+		return Option[T]{}
+	}
+}
+
+func MapGet[K comparable, V any](m map[K]V, key K) Option[V] {
+	value, ok := m[key]
+	return Option[V]{valid: ok, value: value}
+}
+
+func FromLookup[T any](value T, ok bool) Option[T] {
+	if ok {
+		return Option[T]{valid: true, value: value}
+	} else {
+		return Option[T]{}
+	}
+}
+
+func FromError[T any](value T, err error) Option[T] {
+	if err == nil {
+		return Option[T]{valid: true, value: value}
+	} else {
+		return Option[T]{}
+	}
+}
+
+func GetEnv(name string) Option[string] {
+	value, ok := os.LookupEnv(name)
+	return Option[string]{valid: ok, value: value}
+}
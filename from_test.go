@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "testing"
+
+func TestFromLookup(t *testing.T) {
+	if got := FromLookup(5, false); got != None[int]() {
+		t.Errorf("FromLookup(5, false) = %#v, want None", got)
+	}
+	if got := FromLookup(5, false).UnwrapOrZero(); got != 0 {
+		t.Errorf("FromLookup(5, false).UnwrapOrZero() = %d, want 0", got)
+	}
+	if got := FromLookup(5, true); got != Some(5) {
+		t.Errorf("FromLookup(5, true) = %#v, want Some(5)", got)
+	}
+}
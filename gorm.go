@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_gorm
+
+package option
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	_ schema.QueryClausesInterface  = DeletedAt{}
+	_ schema.UpdateClausesInterface = DeletedAt{}
+	_ schema.DeleteClausesInterface = DeletedAt{}
+	_ schema.GormDataTypeInterface  = DeletedAt{}
+)
+
+func (DeletedAt) GormDataType() string {
+	return string(schema.Time)
+}
+
+func (DeletedAt) QueryClauses(f *schema.Field) []clause.Interface {
+	return gorm.DeletedAt{}.QueryClauses(f)
+}
+
+func (DeletedAt) UpdateClauses(f *schema.Field) []clause.Interface {
+	return gorm.DeletedAt{}.UpdateClauses(f)
+}
+
+func (DeletedAt) DeleteClauses(f *schema.Field) []clause.Interface {
+	return gorm.DeletedAt{}.DeleteClauses(f)
+}
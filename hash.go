@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "hash/maphash"
+
+func Hash[T comparable](seed maphash.Seed, o Option[T]) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	if o.valid {
+		h.WriteByte(1)
+		maphash.WriteComparable(&h, o.value)
+	} else {
+		h.WriteByte(0)
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"io"
+	"iter"
+
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+func (o Option[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.valid {
+			yield(o.value)
+		}
+	}
+}
+
+func FirstSome[T any](seq iter.Seq[T], predicate func(T) bool) Option[T] {
+	for value := range seq {
+		if predicate(value) {
+			return Option[T]{valid: true, value: value}
+		}
+	}
+	return Option[T]{}
+}
+
+func CollectFirst[T any](seq iter.Seq[T]) Option[T] {
+	for value := range seq {
+		return Option[T]{valid: true, value: value}
+	}
+	return Option[T]{}
+}
+
+func DecodeSeq[T any](dec *jsontext.Decoder) iter.Seq2[Option[T], error] {
+	return func(yield func(Option[T], error) bool) {
+		array := dec.PeekKind() == jsontext.KindBeginArray
+		if array {
+			if _, err := dec.ReadToken(); err != nil {
+				yield(Option[T]{}, err)
+				return
+			}
+		}
+		for {
+			switch kind := dec.PeekKind(); {
+			case array && kind == jsontext.KindEndArray:
+				if _, err := dec.ReadToken(); err != nil {
+					yield(Option[T]{}, err)
+				}
+				return
+			case !array && kind == jsontext.KindInvalid:
+				if _, err := dec.ReadToken(); err != io.EOF {
+					yield(Option[T]{}, err)
+				}
+				return
+			}
+			var o Option[T]
+			if err := o.UnmarshalJSONFrom(dec); err != nil {
+				yield(o, err)
+				return
+			}
+			if !yield(o, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_jsonschema
+
+package option
+
+import (
+	"reflect"
+
+	"github.com/invopop/jsonschema"
+)
+
+func (Option[T]) JSONSchema() *jsonschema.Schema {
+	r := jsonschema.Reflector{DoNotReference: true, Anonymous: true}
+	s := r.ReflectFromType(reflect.TypeFor[T]())
+	s.Version = ""
+	return &jsonschema.Schema{
+		AnyOf: []*jsonschema.Schema{s, {Type: "null"}},
+	}
+}
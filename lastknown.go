@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"sync"
+	"time"
+)
+
+type LastKnown[T any] struct {
+	mu    sync.RWMutex
+	value Option[T]
+	at    time.Time
+}
+
+func (l *LastKnown[T]) Record(o Option[T]) {
+	l.RecordAt(o, time.Now())
+}
+
+func (l *LastKnown[T]) RecordAt(o Option[T], at time.Time) {
+	if !o.valid {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.value.valid || !at.Before(l.at) {
+		l.value, l.at = o, at
+	}
+}
+
+func (l *LastKnown[T]) Last() (Option[T], time.Time) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.value, l.at
+}
+
+func (l *LastKnown[T]) Current(maxAge time.Duration) Option[T] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.value.valid && time.Since(l.at) <= maxAge {
+		return l.value
+	} else {
+		return Option[T]{}
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Lazy[T any] struct {
+	once     func() Option[T]
+	computed atomic.Bool
+}
+
+func NewLazy[T any](f func() Option[T]) *Lazy[T] {
+	l := new(Lazy[T])
+	l.once = sync.OnceValue(func() Option[T] {
+		o := f()
+		l.computed.Store(true)
+		return o
+	})
+	return l
+}
+
+func (l *Lazy[T]) IsSome() bool {
+	return l.Option().valid
+}
+
+func (l *Lazy[T]) Get() (T, bool) {
+	o := l.Option()
+	return o.value, o.valid
+}
+
+func (l *Lazy[T]) Option() Option[T] {
+	if l.once == nil { // zero value without a function
+		return Option[T]{}
+	}
+	return l.once()
+}
+
+func (l *Lazy[T]) IsComputed() bool {
+	return l.computed.Load()
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "testing"
+
+func TestLazyZeroValue(t *testing.T) {
+	var l Lazy[int]
+	if l.IsSome() {
+		t.Error("zero Lazy reported Some")
+	}
+	if value, ok := l.Get(); ok || value != 0 {
+		t.Errorf("zero Lazy Get() = %d, %t, want 0, false", value, ok)
+	}
+	if o := l.Option(); o != None[int]() {
+		t.Errorf("zero Lazy Option() = %#v, want None", o)
+	}
+}
+
+func TestLazyComputesOnce(t *testing.T) {
+	calls := 0
+	l := NewLazy(func() Option[int] {
+		calls++
+		return Some(42)
+	})
+	if l.IsComputed() {
+		t.Error("Lazy computed before first access")
+	}
+	for range 3 {
+		if o := l.Option(); o != Some(42) {
+			t.Errorf("Option() = %#v, want Some(42)", o)
+		}
+	}
+	if calls != 1 || !l.IsComputed() {
+		t.Errorf("calls = %d, computed = %t, want 1, true", calls, l.IsComputed())
+	}
+}
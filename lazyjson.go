@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+type LazyJSON[T any] struct {
+	raw   jsontext.Value
+	once  sync.Once
+	value Option[T]
+	err   error
+}
+
+func (l *LazyJSON[T]) Get() (Option[T], error) {
+	l.once.Do(func() {
+		if len(l.raw) > 0 {
+			l.err = json.Unmarshal(l.raw, &l.value)
+		}
+	})
+	return l.value, l.err
+}
+
+func (l *LazyJSON[T]) Raw() jsontext.Value {
+	return bytes.Clone(l.raw)
+}
+
+var (
+	_ json.Marshaler       = &LazyJSON[int]{}
+	_ json.Unmarshaler     = &LazyJSON[int]{}
+	_ json.MarshalerTo     = &LazyJSON[int]{}
+	_ json.UnmarshalerFrom = &LazyJSON[int]{}
+)
+
+func (l *LazyJSON[T]) MarshalJSON() ([]byte, error) {
+	if len(l.raw) > 0 {
+		return bytes.Clone(l.raw), nil
+	} else {
+		return []byte("null"), nil
+	}
+}
+
+func (l *LazyJSON[T]) UnmarshalJSON(data []byte) error {
+	*l = LazyJSON[T]{raw: bytes.Clone(data)}
+	return nil
+}
+
+func (l *LazyJSON[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if len(l.raw) > 0 {
+		return enc.WriteValue(l.raw)
+	} else {
+		return enc.WriteToken(jsontext.Null)
+	}
+}
+
+func (l *LazyJSON[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	raw, err := dec.ReadValue()
+	if err != nil {
+		*l = LazyJSON[T]{}
+		return err
+	}
+	*l = LazyJSON[T]{raw: bytes.Clone(raw)}
+	return nil
+}
+
+func (l *LazyJSON[T]) IsZero() bool {
+	return len(l.raw) == 0 || string(l.raw) == "null"
+}
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "testing"
+
+func TestLazyJSONMarshalCopies(t *testing.T) {
+	var l LazyJSON[int]
+	if err := l.UnmarshalJSON([]byte("12")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := l.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[0] = '9'
+	l.Raw()[1] = '9'
+	if got, err := l.Get(); err != nil || got != Some(12) {
+		t.Errorf("Get() = %v, %v after mutating marshaled output, want Some(12)", got, err)
+	}
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+type Link struct {
+	Href      string `json:"href"`
+	Templated bool   `json:"templated,omitzero"`
+	Type      string `json:"type,omitzero"`
+	Name      string `json:"name,omitzero"`
+	Title     string `json:"title,omitzero"`
+}
+
+type Links struct {
+	Self Option[Link] `json:"self,omitzero"`
+	Next Option[Link] `json:"next,omitzero"`
+	Prev Option[Link] `json:"prev,omitzero"`
+}
+
+func Href(href string) Option[Link] {
+	if href != "" {
+		return Some(Link{Href: href})
+	} else {
+		return Option[Link]{}
+	}
+}
+
+func (l Links) IsZero() bool {
+	return !l.Self.valid && !l.Next.valid && !l.Prev.valid
+}
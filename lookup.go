@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+func Lookup[T any](root any, path ...any) Option[T] {
+	current := root
+	for _, key := range path {
+		switch key := key.(type) {
+		case string:
+			m, ok := current.(map[string]any)
+			if !ok {
+				return Option[T]{}
+			}
+			if current, ok = m[key]; !ok {
+				return Option[T]{}
+			}
+		case int:
+			s, ok := current.([]any)
+			if !ok || key < 0 || key >= len(s) {
+				return Option[T]{}
+			}
+			current = s[key]
+		default:
+			return Option[T]{}
+		}
+	}
+	value, ok := current.(T)
+	return Option[T]{valid: ok, value: value}
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+func Merge(dst, patch any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return errors.New("option: merge destination must be a non-nil pointer to a struct")
+	}
+	p := reflect.ValueOf(patch)
+	if p.Kind() == reflect.Pointer {
+		if p.IsNil() {
+			return nil
+		}
+		p = p.Elem()
+	}
+	if p.Kind() != reflect.Struct {
+		return errors.New("option: merge patch must be a struct or a pointer to a struct")
+	}
+	return mergeStruct(d.Elem(), p)
+}
+
+func mergeStruct(dst, patch reflect.Value) error {
+	for i := range patch.NumField() {
+		sf := patch.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		pf, typ := patch.Field(i), sf.Type
+		if typ.Kind() == reflect.Pointer && IsOption(typ.Elem()) {
+			if pf.IsNil() {
+				continue
+			}
+			pf, typ = pf.Elem(), typ.Elem()
+		}
+		df := dst.FieldByName(sf.Name)
+		switch {
+		case IsOption(typ):
+			value, ok := Unpack(pf)
+			if !ok {
+				continue
+			}
+			if !df.IsValid() {
+				return fmt.Errorf("option: merge destination %s has no field %s", dst.Type(), sf.Name)
+			}
+			switch {
+			case typ.AssignableTo(df.Type()):
+				df.Set(pf)
+			case value.Type().AssignableTo(df.Type()):
+				df.Set(value)
+			default:
+				return fmt.Errorf("option: cannot merge field %s of type %s into %s", sf.Name, typ, df.Type())
+			}
+		case sf.Type.Kind() == reflect.Struct && df.IsValid() && df.Kind() == reflect.Struct:
+			if err := mergeStruct(df, pf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
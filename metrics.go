@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "math"
+
+func Float64[N Number](o Option[N]) Option[float64] {
+	if o.valid {
+		return Option[float64]{valid: true, value: float64(o.value)}
+	} else {
+		return Option[float64]{}
+	}
+}
+
+func GaugeFunc[N Number](f func() Option[N]) func() float64 {
+	return func() float64 {
+		return Float64(f()).UnwrapOr(math.NaN())
+	}
+}
+
+func ExpvarFunc[T any](f func() Option[T]) func() any { // importing expvar would register its HTTP handlers
+	return func() any {
+		if o := f(); o.valid {
+			return o.value
+		} else {
+			return nil
+		}
+	}
+}
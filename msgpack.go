@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_msgpack
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+var (
+	_ msgpack.CustomEncoder = Option[int]{}
+	_ msgpack.CustomDecoder = &Option[int]{}
+)
+
+func (o Option[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if o.valid {
+		return enc.Encode(&o.value)
+	} else {
+		return enc.EncodeNil()
+	}
+}
+
+func (o *Option[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+	if code == msgpcode.Nil {
+		*o = Option[T]{}
+		return dec.DecodeNil()
+	}
+	if err := dec.Decode(&o.value); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	o.valid = true
+	return nil
+}
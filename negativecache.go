@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"sync"
+	"time"
+)
+
+type NegativeCache[K comparable] struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[K]negativeEntry
+}
+
+type negativeEntry struct {
+	present bool
+	expires time.Time
+}
+
+func NewNegativeCache[K comparable](ttl time.Duration) *NegativeCache[K] {
+	return &NegativeCache[K]{ttl: ttl, entries: make(map[K]negativeEntry)}
+}
+
+func (c *NegativeCache[K]) MarkAbsent(key K) {
+	c.mark(key, false)
+}
+
+func (c *NegativeCache[K]) MarkPresent(key K) {
+	c.mark(key, true)
+}
+
+func (c *NegativeCache[K]) mark(key K, present bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = negativeEntry{present: present, expires: time.Now().Add(c.ttl)}
+}
+
+func (c *NegativeCache[K]) Forget(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+func (c *NegativeCache[K]) Check(key K) Option[bool] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return Option[bool]{}
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return Option[bool]{}
+	}
+	return Some(entry.present)
+}
+
+func (c *NegativeCache[K]) Prune() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
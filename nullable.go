@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+type Nullable[T any] struct {
+	_       hostLayout
+	present bool
+	value   Option[T]
+}
+
+func Absent[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{present: true}
+}
+
+func Present[T any](value Option[T]) Nullable[T] {
+	return Nullable[T]{present: true, value: value}
+}
+
+func (n Nullable[T]) IsAbsent() bool {
+	return !n.present
+}
+
+func (n Nullable[T]) IsNull() bool {
+	return n.present && !n.value.valid
+}
+
+func (n Nullable[T]) IsPresent() bool {
+	return n.present
+}
+
+func (n Nullable[T]) Get() (Option[T], bool) {
+	return n.value, n.present
+}
+
+func (n Nullable[T]) Option() Option[T] {
+	return n.value
+}
+
+func (n Nullable[T]) String() string {
+	if n.present {
+		return n.value.String()
+	} else {
+		return "Absent"
+	}
+}
+
+var (
+	_ json.Marshaler       = Nullable[int]{}
+	_ json.Unmarshaler     = &Nullable[int]{}
+	_ json.MarshalerTo     = Nullable[int]{}
+	_ json.UnmarshalerFrom = &Nullable[int]{}
+)
+
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return n.value.MarshalJSON()
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.present = true
+	return n.value.UnmarshalJSON(data)
+}
+
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return n.value.MarshalJSONTo(enc)
+}
+
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	n.present = true
+	return n.value.UnmarshalJSONFrom(dec)
+}
+
+func (n Nullable[T]) IsZero() bool {
+	return !n.present
+}
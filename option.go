@@ -4,10 +4,11 @@
 package option
 
 import (
+	"bytes"
+	stdjson "encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
-	"structs"
-	"unsafe"
 
 	"github.com/go-json-experiment/json"
 	"github.com/go-json-experiment/json/jsontext"
@@ -15,11 +16,22 @@
 )
 
 type Option[T any] struct {
-	_     structs.HostLayout
+	_     hostLayout
 	valid bool
 	value T
 }
 
+type OptionOf[T any] interface {
+	IsSome() bool
+	Get() (T, bool)
+}
+
+var (
+	_ OptionOf[int] = Option[int]{}
+	_ OptionOf[int] = &Atomic[int]{}
+	_ OptionOf[int] = &Lazy[int]{}
+)
+
 func Some[T any](value T) Option[T] {
 	return Option[T]{valid: true, value: value}
 }
@@ -46,7 +58,71 @@ func (o Option[T]) IsNoneOr(f func(T) bool) bool {
 
 func (o Option[T]) AsSlice() []T {
 	if o.valid {
-		return unsafe.Slice(&o.value, 1)
+		return asSlice(&o.value)
+	} else {
+		return nil
+	}
+}
+
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.valid
+}
+
+func (o *Option[T]) TakeOk() (T, bool) {
+	value, ok := o.value, o.valid
+	*o = Option[T]{}
+	return value, ok
+}
+
+func (o *Option[T]) Set(value T) {
+	o.valid = true
+	o.value = value
+}
+
+func (o *Option[T]) SetNone() {
+	var zero T
+	o.valid = false
+	o.value = zero
+}
+
+func (o *Option[T]) Reset() {
+	o.SetNone()
+}
+
+func (o *Option[T]) Insert(value T) *T {
+	*o = Option[T]{valid: true, value: value}
+	return &o.value
+}
+
+func (o *Option[T]) GetOrInsert(value T) *T {
+	if !o.valid {
+		*o = Option[T]{valid: true, value: value}
+	}
+	return &o.value
+}
+
+func (o *Option[T]) GetOrInsertWith(f func() T) *T {
+	if !o.valid {
+		*o = Option[T]{valid: true, value: f()}
+	}
+	return &o.value
+}
+
+func (o *Option[T]) Replace(value T) Option[T] {
+	old := *o
+	*o = Option[T]{valid: true, value: value}
+	return old
+}
+
+func (o *Option[T]) Take() Option[T] {
+	old := *o
+	*o = Option[T]{}
+	return old
+}
+
+func (o Option[T]) Ptr() *T {
+	if o.valid {
+		return &o.value
 	} else {
 		return nil
 	}
@@ -68,6 +144,16 @@ func (o Option[T]) Unwrap() T {
 	}
 }
 
+var ErrNone = errors.New("option: value is None")
+
+func (o Option[T]) TryUnwrap() (T, error) {
+	if o.valid {
+		return o.value, nil
+	} else {
+		return o.value, ErrNone
+	}
+}
+
 func (o Option[T]) UnwrapOr(fallback T) T {
 	if o.valid {
 		return o.value
@@ -103,6 +189,26 @@ func (o Option[T]) Inspect(f func(*T)) Option[T] {
 	return o
 }
 
+func (o Option[T]) IfSome(f func(T)) {
+	if o.valid {
+		f(o.value)
+	}
+}
+
+func (o Option[T]) IfNone(f func()) {
+	if !o.valid {
+		f()
+	}
+}
+
+func (o Option[T]) Match(some func(T), none func()) {
+	if o.valid {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
 func (o Option[T]) Map(f func(T) T) Option[T] {
 	if o.valid {
 		return Option[T]{valid: true, value: f(o.value)}
@@ -176,36 +282,66 @@ func (o Option[T]) OrElse(f func() Option[T]) Option[T] {
 )
 
 func (o Option[T]) String() string {
-	if o.valid {
-		return fmt.Sprintf("Some(%v)", elem(&o.value))
-	} else {
+	if !o.valid {
 		return "None"
 	}
+	var buf [64]byte
+	if b, ok := appendValue(append(buf[:0], "Some("...), &o.value); ok {
+		return string(append(b, ')'))
+	}
+	return fmt.Sprintf("Some(%v)", elem(&o.value))
 }
 
 func (o Option[T]) GoString() string {
-	if o.valid {
-		return fmt.Sprintf("option.Some(%#v)", elem(&o.value))
-	} else {
-		return fmt.Sprintf("option.None[%T]()", elem(&o.value))
+	if !o.valid {
+		return "option.None[" + reflect.TypeFor[T]().String() + "]()"
 	}
+	var buf [64]byte
+	if b, ok := appendGoValue(append(buf[:0], "option.Some("...), &o.value); ok {
+		return string(append(b, ')'))
+	}
+	return fmt.Sprintf("option.Some(%#v)", elem(&o.value))
 }
 
 var (
 	_ json.Marshaler       = Option[int]{}
 	_ json.Unmarshaler     = &Option[int]{}
-	_ json.MarshalerTo     = &Option[int]{}
+	_ json.MarshalerTo     = Option[int]{}
 	_ json.UnmarshalerFrom = &Option[int]{}
 )
 
+func Deterministic() json.Options {
+	return json.Deterministic(true)
+}
+
 func (o Option[T]) MarshalJSON() ([]byte, error) {
 	if o.valid {
-		return jsonv1.Marshal(&o.value) // avoid boxing on the heap
+		return marshalJSON(o.value) // keep o itself off the heap
 	} else {
 		return []byte("null"), nil
 	}
 }
 
+func marshalJSON[T any](value T) ([]byte, error) {
+	if raw, ok := rawJSON(&value); ok {
+		if !raw.IsValid() {
+			return nil, fmt.Errorf("option: invalid raw JSON value %q", raw)
+		}
+		return bytes.Clone(raw), nil
+	}
+	return jsonv1.Marshal(&value) // avoid boxing on the heap
+}
+
+func rawJSON[T any](p *T) (jsontext.Value, bool) {
+	if v, ok := any(p).(*jsontext.Value); ok {
+		return *v, len(*v) > 0
+	}
+	if v, ok := any(p).(*stdjson.RawMessage); ok { // distinct from jsontext.Value without GOEXPERIMENT=jsonv2
+		return jsontext.Value(*v), len(*v) > 0
+	}
+	return nil, false
+}
+
 func (o *Option[T]) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		*o = Option[T]{}
@@ -219,9 +355,12 @@ func (o *Option[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (o *Option[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+func (o Option[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if raw, ok := rawJSON(&o.value); ok && o.valid {
+		return enc.WriteValue(raw)
+	}
 	if o.valid {
-		return json.MarshalEncode(enc, &o.value) // avoid boxing on the heap
+		return json.MarshalEncode(enc, &o.value)
 	} else {
 		return enc.WriteToken(jsontext.Null)
 	}
@@ -235,7 +374,8 @@ func (o *Option[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
 		return err
 	case jsontext.KindNull:
 		*o = Option[T]{}
-		return nil
+		_, err := dec.ReadToken()
+		return err
 	default:
 		if err := json.UnmarshalDecode(dec, &o.value); err != nil {
 			*o = Option[T]{}
@@ -246,6 +386,29 @@ func (o *Option[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
 	}
 }
 
+type strictJSONUnmarshaler interface {
+	unmarshalJSONStrict(dec *jsontext.Decoder) error
+}
+
+var strictJSON = json.WithUnmarshalers(json.UnmarshalFromFunc(func(dec *jsontext.Decoder, o strictJSONUnmarshaler) error {
+	return o.unmarshalJSONStrict(dec)
+}))
+
+func StrictJSON() json.Options {
+	return strictJSON
+}
+
+func (o *Option[T]) unmarshalJSONStrict(dec *jsontext.Decoder) error {
+	null := dec.PeekKind() == jsontext.KindNull
+	if err := o.UnmarshalJSONFrom(dec); err != nil {
+		return err
+	}
+	if typ := reflect.TypeFor[T](); null && (typ.Kind() == reflect.Pointer || IsOption(typ)) {
+		return fmt.Errorf("option: null is ambiguous for Option[%s] in strict mode", typ)
+	}
+	return nil
+}
+
 func (o Option[T]) IsZero() bool {
 	if !o.valid {
 		return true
@@ -257,33 +420,3 @@ func (o Option[T]) IsZero() bool {
 }
 
 func (Option[T]) isOption() {}
-
-func IsOption(typ reflect.Type) bool {
-	_, ok := (*(*any)(unsafe.Pointer(&iface{
-		Type: (*iface)(unsafe.Pointer(&typ)).Data,
-		Data: nil,
-	}))).(interface{ isOption() })
-	return ok
-}
-
-//go:nosplit
-func elem[P ~*E, E any](p P) any {
-	if p == nil {
-		return nil
-	}
-	typ := reflect.TypeFor[E]()
-	return *(*any)(unsafe.Pointer(&iface{
-		Type: (*iface)(unsafe.Pointer(&typ)).Data,
-		Data: unsafe.Pointer(noEscape(p)),
-	}))
-}
-
-type iface struct {
-	Type, Data unsafe.Pointer
-}
-
-//go:nosplit
-func noEscape[P ~*E, E any](p P) P {
-	x := uintptr(unsafe.Pointer(p))
-	return P(unsafe.Pointer(x ^ 0)) //nolint:all
-}
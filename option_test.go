@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-json-experiment/json"
+)
+
+type tagOptions struct {
+	N  Option[int64]      `json:"n,string"`
+	T  Option[time.Time]  `json:"t,format:unix"`
+	PN *Option[int64]     `json:"pn,string"`
+	PT *Option[time.Time] `json:"pt,format:unix"`
+}
+
+func TestMarshalJSONTagOptions(t *testing.T) {
+	n := Some[int64](42)
+	ts := Some(time.Unix(1700000000, 0).UTC())
+	opts := json.ExperimentalSupportFormatTag(true)
+	tests := []struct {
+		name string
+		in   tagOptions
+		want string
+	}{
+		{"Some", tagOptions{N: n, T: ts, PN: &n, PT: &ts}, `{"n":"42","t":1700000000,"pn":"42","pt":1700000000}`},
+		{"None", tagOptions{}, `{"n":null,"t":null,"pn":null,"pt":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, in := range []any{tt.in, &tt.in} {
+				data, err := json.Marshal(in, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(data) != tt.want {
+					t.Errorf("Marshal(%T) = %s, want %s", in, data, tt.want)
+				}
+			}
+			var got tagOptions
+			if err := json.Unmarshal([]byte(tt.want), &got, opts); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.in) {
+				t.Errorf("Unmarshal(%s) = %+v, want %+v", tt.want, got, tt.in)
+			}
+		})
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	b.Run("Int", func(b *testing.B) {
+		o := Some(42)
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := o.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("String", func(b *testing.B) {
+		o := Some("hello, world")
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := o.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("None", func(b *testing.B) {
+		o := None[int]()
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := o.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestStrictJSON(t *testing.T) {
+	type doc struct {
+		P  Option[*int]        `json:"p"`
+		N  Option[Option[int]] `json:"n"`
+		I  Option[int]         `json:"i"`
+		In []Option[*int]      `json:"in"`
+	}
+	tests := []struct {
+		data    string
+		wantErr bool
+	}{
+		{`{"i":null}`, false},
+		{`{"p":1,"n":2,"in":[3]}`, false},
+		{`{"p":null}`, true},
+		{`{"n":null}`, true},
+		{`{"in":[null]}`, true},
+		{`{"i":1} x`, true},
+	}
+	for _, tt := range tests {
+		var d doc
+		err := json.Unmarshal([]byte(tt.data), &d, StrictJSON())
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Unmarshal(%s, StrictJSON()) error = %v, wantErr %t", tt.data, err, tt.wantErr)
+		}
+	}
+	var d doc
+	if err := json.Unmarshal([]byte(`{"p":null,"n":null}`), &d); err != nil {
+		t.Errorf("Unmarshal without StrictJSON: %v", err)
+	}
+}
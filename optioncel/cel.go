@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optioncel
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/antoniszymanski/option-go"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+)
+
+func Adapter(base types.Adapter) types.Adapter {
+	return adapter{base}
+}
+
+type adapter struct {
+	base types.Adapter
+}
+
+func (a adapter) NativeToValue(value any) ref.Val {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || !option.IsOption(rv.Type()) {
+		return a.base.NativeToValue(value)
+	}
+	if v, ok := unpack(rv); ok {
+		return a.base.NativeToValue(v)
+	} else {
+		return types.NullValue
+	}
+}
+
+func NativeTypes(args ...any) cel.EnvOption {
+	return func(env *cel.Env) (*cel.Env, error) {
+		env, err := ext.NativeTypes(args...)(env)
+		if err != nil {
+			return nil, err
+		}
+		p := &provider{Provider: env.CELTypeProvider(), types: make(map[string]reflect.Type)}
+		for _, arg := range args {
+			switch arg := arg.(type) {
+			case reflect.Type:
+				p.register(arg)
+			case reflect.Value:
+				p.register(arg.Type())
+			}
+		}
+		env, err = cel.CustomTypeAdapter(Adapter(env.CELTypeAdapter()))(env)
+		if err != nil {
+			return nil, err
+		}
+		return cel.CustomTypeProvider(p)(env)
+	}
+}
+
+type provider struct {
+	types.Provider
+	types map[string]reflect.Type
+}
+
+func (p *provider) register(typ reflect.Type) {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		p.register(typ.Elem())
+		return
+	case reflect.Struct:
+	default:
+		return
+	}
+	if option.IsOption(typ) {
+		p.register(option.OptionElem(typ))
+		return
+	}
+	name := typeName(typ)
+	if _, ok := p.types[name]; ok {
+		return
+	}
+	p.types[name] = typ
+	for i := range typ.NumField() {
+		p.register(typ.Field(i).Type)
+	}
+}
+
+func (p *provider) FindStructFieldType(typeName, fieldName string) (*types.FieldType, bool) {
+	ft, ok := p.Provider.FindStructFieldType(typeName, fieldName)
+	if !ok {
+		return ft, ok
+	}
+	typ, ok := p.types[typeName]
+	if !ok {
+		return ft, true
+	}
+	field, ok := findField(typ, fieldName)
+	if !ok || !option.IsOption(field.Type) {
+		return ft, true
+	}
+	return &types.FieldType{
+		Type: cel.DynType,
+		IsSet: func(obj any) bool {
+			_, ok := unwrapField(obj, field)
+			return ok
+		},
+		GetFrom: func(obj any) (any, error) {
+			v, _ := unwrapField(obj, field)
+			return v, nil
+		},
+	}, true
+}
+
+func typeName(typ reflect.Type) string {
+	pkg := typ.PkgPath()
+	if i := strings.LastIndexByte(pkg, '/'); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	return pkg + "." + typ.Name()
+}
+
+func findField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if tag, _, _ := strings.Cut(field.Tag.Get("cel"), ","); field.Name == name || tag == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func unwrapField(obj any, field reflect.StructField) (any, bool) {
+	return unpack(reflect.Indirect(reflect.ValueOf(obj)).FieldByIndex(field.Index))
+}
+
+func unpack(rv reflect.Value) (any, bool) {
+	if v, ok := option.Unpack(rv); ok {
+		return v.Interface(), true
+	}
+	return nil, false
+}
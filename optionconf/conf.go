@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionconf
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/antoniszymanski/option-go"
+)
+
+type Getter interface {
+	GetString(key string) option.Option[string]
+}
+
+type GetterFunc func(key string) option.Option[string]
+
+func (f GetterFunc) GetString(key string) option.Option[string] {
+	return f(key)
+}
+
+func Env() Getter {
+	return GetterFunc(option.GetEnv)
+}
+
+func Values(values url.Values) Getter {
+	return GetterFunc(option.QueryValues(values).Get)
+}
+
+func Map(m map[string]string) Getter {
+	return GetterFunc(func(key string) option.Option[string] {
+		return option.MapGet(m, key)
+	})
+}
+
+func Or(getters ...Getter) Getter {
+	return GetterFunc(func(key string) option.Option[string] {
+		for _, g := range getters {
+			if o := g.GetString(key); o.IsSome() {
+				return o
+			}
+		}
+		return option.None[string]()
+	})
+}
+
+func Get[T any](g Getter, key string) (option.Option[T], error) {
+	s, ok := g.GetString(key).Get()
+	if !ok {
+		return option.None[T](), nil
+	}
+	if s == "" && reflect.TypeFor[T]().Kind() == reflect.String { // an empty value is Some(""), not None
+		var zero T
+		return option.Some(zero), nil
+	}
+	var o option.Option[T]
+	if err := o.UnmarshalText([]byte(s)); err != nil {
+		return option.None[T](), fmt.Errorf("optionconf: key %q: %w", key, err)
+	}
+	return o, nil
+}
+
+func GetInt(g Getter, key string) (option.Option[int], error) {
+	return Get[int](g, key)
+}
+
+func GetFloat(g Getter, key string) (option.Option[float64], error) {
+	return Get[float64](g, key)
+}
+
+func GetBool(g Getter, key string) (option.Option[bool], error) {
+	return Get[bool](g, key)
+}
+
+func GetDuration(g Getter, key string) (option.Option[time.Duration], error) {
+	return Get[time.Duration](g, key)
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionconf
+
+import (
+	"testing"
+
+	"github.com/antoniszymanski/option-go"
+)
+
+func TestGetEmpty(t *testing.T) {
+	g := Map(map[string]string{"name": "", "port": ""})
+	if got, err := Get[string](g, "name"); err != nil || got != option.Some("") {
+		t.Errorf("Get[string](name) = %#v, %v, want Some(\"\")", got, err)
+	}
+	if got, err := Get[string](g, "missing"); err != nil || got.IsSome() {
+		t.Errorf("Get[string](missing) = %#v, %v, want None", got, err)
+	}
+	if got, err := GetInt(g, "port"); err != nil || got.IsSome() {
+		t.Errorf("GetInt(port) = %#v, %v, want None", got, err)
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionexpr
+
+import (
+	"reflect"
+
+	"github.com/antoniszymanski/option-go"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/conf"
+)
+
+const funcName = "$option"
+
+func Unwrap() expr.Option {
+	return func(c *conf.Config) {
+		expr.Function(funcName, func(params ...any) (any, error) {
+			return unwrap(params[0]), nil
+		})(c)
+		expr.Patch(patcher{})(c)
+	}
+}
+
+type patcher struct{}
+
+func (patcher) Visit(node *ast.Node) {
+	if typ := (*node).Type(); typ == nil || !option.IsOption(typ) {
+		return
+	}
+	ast.Patch(node, &ast.CallNode{
+		Callee:    &ast.IdentifierNode{Value: funcName},
+		Arguments: []ast.Node{*node},
+	})
+}
+
+func unwrap(v any) any {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || !option.IsOption(rv.Type()) {
+		return v
+	}
+	if v, ok := option.Unpack(rv); ok {
+		return v.Interface()
+	}
+	return nil
+}
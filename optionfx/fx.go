@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionfx
+
+import (
+	"github.com/antoniszymanski/option-go"
+	"go.uber.org/fx"
+)
+
+type presence[T any] struct {
+	ok bool
+}
+
+type params[T any] struct {
+	fx.In
+
+	Value    T           `optional:"true"`
+	Presence presence[T] `optional:"true"`
+}
+
+func Provide[T any]() fx.Option {
+	return fx.Provide(
+		func(T) presence[T] { return presence[T]{ok: true} }, // unresolvable when no T is bound
+		func(p params[T]) option.Option[T] {
+			if p.Presence.ok {
+				return option.Some(p.Value)
+			}
+			return option.None[T]()
+		},
+	)
+}
+
+func Supply[T any](o option.Option[T]) fx.Option {
+	if o.IsNone() {
+		return fx.Options()
+	}
+	value := o.Unwrap()
+	return fx.Provide(func() T { return value })
+}
+
+func Collect[T any](group string) fx.Option {
+	return fx.Provide(fx.Annotate(
+		func(opts []option.Option[T]) []option.Option[T] {
+			return opts
+		},
+		fx.ParamTags(`group:"`+group+`"`),
+		fx.ResultTags(`name:"`+group+`"`),
+	))
+}
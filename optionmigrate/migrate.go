@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionmigrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+type Move struct {
+	From string
+	To   string
+}
+
+type Mapping []Move
+
+func Apply(doc []byte, mapping Mapping) ([]byte, error) {
+	dec := jsontext.NewDecoder(bytes.NewReader(doc))
+	root, err := decode(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.ReadToken(); err != io.EOF {
+		return nil, errors.New("optionmigrate: unexpected data after top-level value")
+	}
+	for _, move := range mapping {
+		if err := apply(root, move); err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	enc := jsontext.NewEncoder(&buf)
+	if err := encode(enc, root); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+type object struct {
+	members []member
+}
+
+type member struct {
+	name  string
+	value any
+}
+
+func (o *object) index(name string) int {
+	for i, m := range o.members {
+		if m.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func apply(root any, move Move) error {
+	from, err := parsePointer(move.From)
+	if err != nil {
+		return err
+	}
+	to, err := parsePointer(move.To)
+	if err != nil {
+		return err
+	}
+	parent, err := walk(root, from[:len(from)-1], false)
+	if err != nil || parent == nil {
+		return err
+	}
+	i := parent.index(from[len(from)-1])
+	if i < 0 {
+		return nil
+	}
+	value := parent.members[i].value
+	parent.members = append(parent.members[:i], parent.members[i+1:]...)
+	parent, err = walk(root, to[:len(to)-1], true)
+	if err != nil {
+		return err
+	}
+	if i := parent.index(to[len(to)-1]); i >= 0 {
+		parent.members[i].value = value
+	} else {
+		parent.members = append(parent.members, member{name: to[len(to)-1], value: value})
+	}
+	return nil
+}
+
+func walk(node any, path []string, create bool) (*object, error) {
+	for i, name := range path {
+		obj, ok := node.(*object)
+		if !ok {
+			return nil, fmt.Errorf("optionmigrate: %q is not an object", "/"+strings.Join(path[:i], "/"))
+		}
+		j := obj.index(name)
+		switch {
+		case j >= 0:
+			node = obj.members[j].value
+		case create:
+			node = &object{}
+			obj.members = append(obj.members, member{name: name, value: node})
+		default:
+			return nil, nil
+		}
+	}
+	obj, ok := node.(*object)
+	if !ok {
+		return nil, fmt.Errorf("optionmigrate: %q is not an object", "/"+strings.Join(path, "/"))
+	}
+	return obj, nil
+}
+
+func parsePointer(ptr string) ([]string, error) {
+	if !strings.HasPrefix(ptr, "/") {
+		return nil, fmt.Errorf("optionmigrate: invalid JSON pointer %q", ptr)
+	}
+	tokens := strings.Split(ptr[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func decode(dec *jsontext.Decoder) (any, error) {
+	if dec.PeekKind() != '{' {
+		value, err := dec.ReadValue()
+		return value.Clone(), err
+	}
+	if _, err := dec.ReadToken(); err != nil {
+		return nil, err
+	}
+	obj := new(object)
+	for dec.PeekKind() != '}' {
+		tok, err := dec.ReadToken()
+		if err != nil {
+			return nil, err
+		}
+		name := tok.String()
+		value, err := decode(dec)
+		if err != nil {
+			return nil, err
+		}
+		obj.members = append(obj.members, member{name: name, value: value})
+	}
+	_, err := dec.ReadToken()
+	return obj, err
+}
+
+func encode(enc *jsontext.Encoder, node any) error {
+	obj, ok := node.(*object)
+	if !ok {
+		return enc.WriteValue(node.(jsontext.Value))
+	}
+	if err := enc.WriteToken(jsontext.BeginObject); err != nil {
+		return err
+	}
+	for _, m := range obj.members {
+		if err := enc.WriteToken(jsontext.String(m.name)); err != nil {
+			return err
+		}
+		if err := encode(enc, m.value); err != nil {
+			return err
+		}
+	}
+	return enc.WriteToken(jsontext.EndObject)
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionproto
+
+import (
+	"time"
+
+	"github.com/antoniszymanski/option-go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func FromStringValue(w *wrapperspb.StringValue) option.Option[string] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[string]()
+	}
+}
+
+func ToStringValue(o option.Option[string]) *wrapperspb.StringValue {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.String(value)
+	} else {
+		return nil
+	}
+}
+
+func FromBoolValue(w *wrapperspb.BoolValue) option.Option[bool] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[bool]()
+	}
+}
+
+func ToBoolValue(o option.Option[bool]) *wrapperspb.BoolValue {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.Bool(value)
+	} else {
+		return nil
+	}
+}
+
+func FromInt32Value(w *wrapperspb.Int32Value) option.Option[int32] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[int32]()
+	}
+}
+
+func ToInt32Value(o option.Option[int32]) *wrapperspb.Int32Value {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.Int32(value)
+	} else {
+		return nil
+	}
+}
+
+func FromInt64Value(w *wrapperspb.Int64Value) option.Option[int64] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[int64]()
+	}
+}
+
+func ToInt64Value(o option.Option[int64]) *wrapperspb.Int64Value {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.Int64(value)
+	} else {
+		return nil
+	}
+}
+
+func FromUInt32Value(w *wrapperspb.UInt32Value) option.Option[uint32] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[uint32]()
+	}
+}
+
+func ToUInt32Value(o option.Option[uint32]) *wrapperspb.UInt32Value {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.UInt32(value)
+	} else {
+		return nil
+	}
+}
+
+func FromUInt64Value(w *wrapperspb.UInt64Value) option.Option[uint64] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[uint64]()
+	}
+}
+
+func ToUInt64Value(o option.Option[uint64]) *wrapperspb.UInt64Value {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.UInt64(value)
+	} else {
+		return nil
+	}
+}
+
+func FromFloatValue(w *wrapperspb.FloatValue) option.Option[float32] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[float32]()
+	}
+}
+
+func ToFloatValue(o option.Option[float32]) *wrapperspb.FloatValue {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.Float(value)
+	} else {
+		return nil
+	}
+}
+
+func FromDoubleValue(w *wrapperspb.DoubleValue) option.Option[float64] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[float64]()
+	}
+}
+
+func ToDoubleValue(o option.Option[float64]) *wrapperspb.DoubleValue {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.Double(value)
+	} else {
+		return nil
+	}
+}
+
+func FromBytesValue(w *wrapperspb.BytesValue) option.Option[[]byte] {
+	if w != nil {
+		return option.Some(w.GetValue())
+	} else {
+		return option.None[[]byte]()
+	}
+}
+
+func ToBytesValue(o option.Option[[]byte]) *wrapperspb.BytesValue {
+	if value, ok := o.Get(); ok {
+		return wrapperspb.Bytes(value)
+	} else {
+		return nil
+	}
+}
+
+func FromTimestamp(ts *timestamppb.Timestamp) option.Option[time.Time] {
+	if ts != nil {
+		return option.Some(ts.AsTime())
+	} else {
+		return option.None[time.Time]()
+	}
+}
+
+func ToTimestamp(o option.Option[time.Time]) *timestamppb.Timestamp {
+	if value, ok := o.Get(); ok {
+		return timestamppb.New(value)
+	} else {
+		return nil
+	}
+}
+
+func FromDuration(d *durationpb.Duration) option.Option[time.Duration] {
+	if d != nil {
+		return option.Some(d.AsDuration())
+	} else {
+		return option.None[time.Duration]()
+	}
+}
+
+func ToDuration(o option.Option[time.Duration]) *durationpb.Duration {
+	if value, ok := o.Get(); ok {
+		return durationpb.New(value)
+	} else {
+		return nil
+	}
+}
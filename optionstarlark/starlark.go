@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionstarlark
+
+import (
+	"fmt"
+
+	"github.com/antoniszymanski/option-go"
+	"go.starlark.net/starlark"
+)
+
+func ToValue[T any](o option.Option[T]) (starlark.Value, error) {
+	if o.IsNone() {
+		return starlark.None, nil
+	}
+	switch v := any(o.Unwrap()).(type) {
+	case starlark.Value:
+		return v, nil
+	case string:
+		return starlark.String(v), nil
+	case bool:
+		return starlark.Bool(v), nil
+	case int:
+		return starlark.MakeInt(v), nil
+	case int8:
+		return starlark.MakeInt64(int64(v)), nil
+	case int16:
+		return starlark.MakeInt64(int64(v)), nil
+	case int32:
+		return starlark.MakeInt64(int64(v)), nil
+	case int64:
+		return starlark.MakeInt64(v), nil
+	case uint:
+		return starlark.MakeUint(v), nil
+	case uint8:
+		return starlark.MakeUint64(uint64(v)), nil
+	case uint16:
+		return starlark.MakeUint64(uint64(v)), nil
+	case uint32:
+		return starlark.MakeUint64(uint64(v)), nil
+	case uint64:
+		return starlark.MakeUint64(v), nil
+	case float32:
+		return starlark.Float(v), nil
+	case float64:
+		return starlark.Float(v), nil
+	default:
+		return nil, fmt.Errorf("optionstarlark: cannot convert %T to a Starlark value", v)
+	}
+}
+
+func FromValue[T any](v starlark.Value) (option.Option[T], error) {
+	if v == nil || v == starlark.None {
+		return option.None[T](), nil
+	}
+	var value T
+	if err := starlark.UnpackArg(v, &value); err != nil {
+		return option.None[T](), err
+	}
+	return option.Some(value), nil
+}
+
+type Arg[T any] struct {
+	option.Option[T]
+}
+
+var _ starlark.Unpacker = &Arg[int]{}
+
+func (a *Arg[T]) Unpack(v starlark.Value) error {
+	o, err := FromValue[T](v)
+	if err != nil {
+		return err
+	}
+	a.Option = o
+	return nil
+}
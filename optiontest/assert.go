@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optiontest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/antoniszymanski/option-go"
+	"github.com/google/go-cmp/cmp"
+)
+
+func AssertSome[T any](t testing.TB, o option.Option[T]) T {
+	t.Helper()
+	value, ok := o.Get()
+	if !ok {
+		t.Fatalf("got None, want Some(%T)", value)
+	}
+	return value
+}
+
+func AssertNone[T any](t testing.TB, o option.Option[T]) {
+	t.Helper()
+	if o.IsSome() {
+		t.Fatalf("got %v, want None", o)
+	}
+}
+
+func AssertSomeEqual[T any](t testing.TB, o option.Option[T], want T, opts ...cmp.Option) {
+	t.Helper()
+	got := AssertSome(t, o)
+	if diff := Diff(want, got, opts...); diff != "" {
+		t.Errorf("Some value mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Diff(want, got any, opts ...cmp.Option) string {
+	return cmp.Diff(want, got, append(opts, transformer)...)
+}
+
+func CmpTransformer() cmp.Option {
+	return transformer
+}
+
+var transformer = cmp.FilterValues(
+	func(x, y any) bool {
+		return isOption(x) && isOption(y)
+	},
+	cmp.Transformer("option", func(o any) any {
+		if v, ok := option.Unpack(reflect.ValueOf(o)); ok {
+			return v.Interface()
+		}
+		return nil
+	}),
+)
+
+func isOption(v any) bool {
+	return v != nil && option.IsOption(reflect.TypeOf(v))
+}
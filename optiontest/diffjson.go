@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optiontest
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-json-experiment/json"
+)
+
+func DiffJSON(t testing.TB, want, got any) {
+	t.Helper()
+	w, err := decodeJSON(want)
+	if err != nil {
+		t.Fatalf("marshaling want: %v", err)
+	}
+	g, err := decodeJSON(got)
+	if err != nil {
+		t.Fatalf("marshaling got: %v", err)
+	}
+	var b strings.Builder
+	diffJSON(&b, "", w, g)
+	if b.Len() > 0 {
+		t.Errorf("JSON mismatch (-want +got):\n%s", b.String())
+	}
+}
+
+func decodeJSON(v any) (any, error) {
+	data, err := json.Marshal(v, json.Deterministic(true))
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
+
+const (
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorReset  = "\x1b[0m"
+)
+
+func diffJSON(b *strings.Builder, path string, want, got any) {
+	switch {
+	case want == nil && got == nil:
+	case want == nil:
+		writeDiff(b, colorGreen, "set", path, want, got)
+	case got == nil:
+		writeDiff(b, colorYellow, "nulled", path, want, got)
+	default:
+		switch w := want.(type) {
+		case map[string]any:
+			if g, ok := got.(map[string]any); ok {
+				keys := slices.AppendSeq(slices.Collect(maps.Keys(w)), maps.Keys(g))
+				slices.Sort(keys)
+				for _, k := range slices.Compact(keys) {
+					p := path + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(k)
+					wv, wok := w[k]
+					gv, gok := g[k]
+					switch {
+					case !gok:
+						writeDiff(b, colorRed, "removed", p, wv, nil)
+					case !wok:
+						writeDiff(b, colorGreen, "added", p, nil, gv)
+					default:
+						diffJSON(b, p, wv, gv)
+					}
+				}
+				return
+			}
+		case []any:
+			if g, ok := got.([]any); ok {
+				for i := range max(len(w), len(g)) {
+					p := path + "/" + strconv.Itoa(i)
+					switch {
+					case i >= len(g):
+						writeDiff(b, colorRed, "removed", p, w[i], nil)
+					case i >= len(w):
+						writeDiff(b, colorGreen, "added", p, nil, g[i])
+					default:
+						diffJSON(b, p, w[i], g[i])
+					}
+				}
+				return
+			}
+		}
+		if !reflect.DeepEqual(want, got) {
+			writeDiff(b, colorYellow, "changed", path, want, got)
+		}
+	}
+}
+
+func writeDiff(b *strings.Builder, color, label, path string, want, got any) {
+	if path == "" {
+		path = "/"
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		color = ""
+	}
+	reset := colorReset
+	if color == "" {
+		reset = ""
+	}
+	fmt.Fprintf(b, "%s%s %s%s\n", color, label, path, reset)
+	if label != "added" {
+		fmt.Fprintf(b, "\t- %s\n", formatJSON(want))
+	}
+	if label != "removed" {
+		fmt.Fprintf(b, "\t+ %s\n", formatJSON(got))
+	}
+}
+
+func formatJSON(v any) string {
+	data, err := json.Marshal(v, json.Deterministic(true))
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optiontest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/antoniszymanski/option-go"
+)
+
+var _ quick.Generator = Generator[int]{}
+
+type Generator[T any] struct {
+	option.Option[T]
+}
+
+func (Generator[T]) Generate(rand *rand.Rand, size int) reflect.Value {
+	if rand.Intn(4) == 0 {
+		return reflect.ValueOf(Generator[T]{})
+	}
+	value, ok := quick.Value(reflect.TypeFor[T](), rand)
+	if !ok {
+		panic(fmt.Sprintf("optiontest: cannot generate values of type %s", reflect.TypeFor[T]()))
+	}
+	return reflect.ValueOf(Generator[T]{option.Some(value.Interface().(T))})
+}
+
+func AddSeeds[T any](f *testing.F, values ...T) {
+	f.Helper()
+	seeds := []option.Option[T]{option.None[T]()}
+	for _, value := range values {
+		seeds = append(seeds, option.Some(value))
+	}
+	for _, o := range seeds {
+		data, err := o.MarshalBinary()
+		if err != nil {
+			f.Fatalf("marshaling seed %v: %v", o, err)
+		}
+		f.Add(data)
+	}
+}
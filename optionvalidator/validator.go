@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package optionvalidator
+
+import (
+	"reflect"
+
+	"github.com/antoniszymanski/option-go"
+	"github.com/go-playground/validator/v10"
+)
+
+func Register(v *validator.Validate, types ...any) {
+	v.RegisterCustomTypeFunc(unwrap, types...)
+}
+
+func RegisterType[T any](v *validator.Validate) {
+	v.RegisterCustomTypeFunc(unwrap, option.Option[T]{})
+}
+
+func unwrap(field reflect.Value) any {
+	if v, ok := option.Unpack(field); ok {
+		return v.Interface()
+	}
+	return nil
+}
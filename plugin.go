@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"reflect"
+	"sync"
+)
+
+func Discover[T any](candidates ...func() (T, bool)) Option[T] {
+	for _, candidate := range candidates {
+		if value, ok := candidate(); ok {
+			return Some(value)
+		}
+	}
+	return Option[T]{}
+}
+
+var plugins sync.Map
+
+func RegisterPlugin[T any](plugin T) {
+	plugins.Store(reflect.TypeFor[T](), plugin)
+}
+
+func Plugin[T any]() Option[T] {
+	if plugin, ok := plugins.Load(reflect.TypeFor[T]()); ok {
+		return Some(plugin.(T))
+	} else {
+		return Option[T]{}
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build purego
+
+package option
+
+import "reflect"
+
+type hostLayout = struct{}
+
+func asSlice[T any](p *T) []T {
+	return []T{*p}
+}
+
+func IsOption(typ reflect.Type) bool {
+	return typ != nil && typ.Kind() == reflect.Struct && typ.Implements(reflect.TypeFor[interface{ isOption() }]())
+}
+
+func elem[P ~*E, E any](p P) any {
+	if p == nil {
+		return nil
+	}
+	return any(*p)
+}
+
+func noEscape[P ~*E, E any](p P) P {
+	return p
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+type queryEncoder interface {
+	EncodeValues(key string, v *url.Values) error
+}
+
+var _ queryEncoder = Option[int]{}
+
+type QueryValues url.Values
+
+func Query(u *url.URL) QueryValues {
+	return QueryValues(u.Query())
+}
+
+func (q QueryValues) Get(key string) Option[string] {
+	if vs, ok := q[key]; ok && len(vs) > 0 {
+		return Some(vs[0])
+	} else {
+		return Option[string]{}
+	}
+}
+
+func (q QueryValues) GetInt(key string) (Option[int], error) {
+	return parseQuery(q, key, strconv.Atoi)
+}
+
+func (q QueryValues) GetFloat(key string) (Option[float64], error) {
+	return parseQuery(q, key, func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+func (q QueryValues) GetBool(key string) (Option[bool], error) {
+	return parseQuery(q, key, strconv.ParseBool)
+}
+
+func (q QueryValues) GetDuration(key string) (Option[time.Duration], error) {
+	return parseQuery(q, key, time.ParseDuration)
+}
+
+func (q QueryValues) GetTime(key, layout string) (Option[time.Time], error) {
+	return parseQuery(q, key, func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	})
+}
+
+func parseQuery[T any](q QueryValues, key string, parse func(string) (T, error)) (Option[T], error) {
+	s := q.Get(key)
+	if !s.valid {
+		return Option[T]{}, nil
+	}
+	value, err := parse(s.value)
+	if err != nil {
+		return Option[T]{}, fmt.Errorf("option: query parameter %q: %w", key, err)
+	}
+	return Some(value), nil
+}
+
+func DecodeQuery(values url.Values, dst any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return errors.New("option: query destination must be a non-nil pointer to a struct")
+	}
+	q := QueryValues(values)
+	d = d.Elem()
+	for i := range d.NumField() {
+		sf := d.Type().Field(i)
+		ptr := sf.Type.Kind() == reflect.Pointer && IsOption(sf.Type.Elem())
+		if !sf.IsExported() || !ptr && !IsOption(sf.Type) {
+			continue
+		}
+		key := sf.Name
+		if tag, ok := sf.Tag.Lookup("query"); ok {
+			if tag == "-" {
+				continue
+			}
+			key = tag
+		}
+		f := d.Field(i)
+		if !values.Has(key) {
+			f.SetZero()
+			continue
+		}
+		if ptr {
+			if f.IsNil() {
+				f.Set(reflect.New(sf.Type.Elem()))
+			}
+			f = f.Elem()
+		}
+		text := q.Get(key).value
+		if text == "" && OptionElem(f.Type()).Kind() == reflect.String { // ?key= is Some("") rather than None
+			f.Set(NewSome(f.Type(), reflect.Zero(OptionElem(f.Type()))))
+			continue
+		}
+		u, ok := f.Addr().Interface().(encoding.TextUnmarshaler)
+		if !ok {
+			return fmt.Errorf("option: query field %s of type %s cannot be decoded", sf.Name, sf.Type)
+		}
+		if err := u.UnmarshalText([]byte(text)); err != nil {
+			return fmt.Errorf("option: query parameter %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (o Option[T]) EncodeValues(key string, v *url.Values) error {
+	if !o.valid {
+		return nil
+	}
+	if e, ok := marshalerOf[queryEncoder](&o.value); ok {
+		return e.EncodeValues(key, v)
+	}
+	text, err := marshalText(&o.value)
+	if err != nil {
+		return err
+	}
+	v.Add(key, string(text))
+	return nil
+}
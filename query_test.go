@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"net/url"
+	"testing"
+)
+
+type queryDoc struct {
+	Q     Option[string]  `query:"q"`
+	N     Option[int]     `query:"n"`
+	P     *Option[string] `query:"p"`
+	Other Option[string]
+}
+
+func TestDecodeQueryPresence(t *testing.T) {
+	values, err := url.ParseQuery("q=&n=3&p=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := queryDoc{Other: Some("stale")}
+	if err := DecodeQuery(values, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Q != Some("") {
+		t.Errorf("q = %#v, want Some(\"\")", doc.Q)
+	}
+	if doc.N != Some(3) {
+		t.Errorf("n = %#v, want Some(3)", doc.N)
+	}
+	if doc.P == nil || *doc.P != Some("") {
+		t.Errorf("p = %v, want &Some(\"\")", doc.P)
+	}
+	if doc.Other.IsSome() {
+		t.Errorf("Other = %#v, want None for a missing key", doc.Other)
+	}
+}
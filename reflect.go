@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func IsOptionType(typ reflect.Type) bool {
+	return IsOption(typ)
+}
+
+func OptionElem(typ reflect.Type) reflect.Type {
+	if !IsOption(typ) {
+		return nil
+	}
+	field, _ := typ.FieldByName("value")
+	return field.Type
+}
+
+func Unpack(v reflect.Value) (reflect.Value, bool) {
+	if !v.IsValid() || !IsOption(v.Type()) {
+		return reflect.Value{}, false
+	}
+	out := v.MethodByName("Get").Call(nil)
+	if !out[1].Bool() {
+		return reflect.Value{}, false
+	}
+	return out[0], true
+}
+
+func NewSome(typ reflect.Type, value reflect.Value) reflect.Value {
+	if !IsOption(typ) {
+		panic(fmt.Sprintf("option: NewSome called with non-option type %s", typ))
+	}
+	o := reflect.New(typ)
+	o.MethodByName("Set").Call([]reflect.Value{value})
+	return o.Elem()
+}
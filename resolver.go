@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"slices"
+	"sync"
+)
+
+type Resolver[T any] struct {
+	mu       sync.RWMutex
+	fallback T
+	parents  map[string]string
+	values   map[string]Option[T]
+}
+
+type Explanation[T any] struct {
+	Value  T
+	Source Option[string]
+	Path   []string
+}
+
+func NewResolver[T any](fallback T) *Resolver[T] {
+	return &Resolver[T]{
+		fallback: fallback,
+		parents:  make(map[string]string),
+		values:   make(map[string]Option[T]),
+	}
+}
+
+func (r *Resolver[T]) Set(id string, value Option[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if value.valid {
+		r.values[id] = value
+	} else {
+		delete(r.values, id)
+	}
+}
+
+func (r *Resolver[T]) SetParent(id, parent string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.parents[id] = parent
+}
+
+func (r *Resolver[T]) Resolve(id string) T {
+	return r.Explain(id).Value
+}
+
+func (r *Resolver[T]) Explain(id string) Explanation[T] {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var path []string
+	for !slices.Contains(path, id) {
+		path = append(path, id)
+		if value := r.values[id]; value.valid {
+			return Explanation[T]{Value: value.value, Source: Some(id), Path: path}
+		}
+		parent, ok := r.parents[id]
+		if !ok {
+			break
+		}
+		id = parent
+	}
+	return Explanation[T]{Value: r.fallback, Path: path}
+}
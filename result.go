@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "fmt"
+
+type Result[T any] struct {
+	_     hostLayout
+	value T
+	err   error
+}
+
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("called Err with a nil error")
+	}
+	return Result[T]{err: err}
+}
+
+func TryResult[T any](value T, err error) Result[T] {
+	if err == nil {
+		return Result[T]{value: value}
+	} else {
+		return Result[T]{err: err}
+	}
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+func (r Result[T]) Expect(msg string) T {
+	if r.err == nil {
+		return r.value
+	} else {
+		panic(msg + ": " + r.err.Error())
+	}
+}
+
+func (r Result[T]) Unwrap() T {
+	if r.err == nil {
+		return r.value
+	} else {
+		panic("called Unwrap on an Err value: " + r.err.Error())
+	}
+}
+
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err == nil {
+		return r.value
+	} else {
+		return fallback
+	}
+}
+
+func (r Result[T]) UnwrapOrZero() T {
+	return r.value
+}
+
+func (r Result[T]) UnwrapErr() error {
+	if r.err != nil {
+		return r.err
+	} else {
+		panic("called UnwrapErr on an Ok value")
+	}
+}
+
+func (r Result[T]) Ok() Option[T] {
+	if r.err == nil {
+		return Option[T]{valid: true, value: r.value}
+	} else {
+		return Option[T]{}
+	}
+}
+
+func (r Result[T]) Err() Option[error] {
+	if r.err != nil {
+		return Option[error]{valid: true, value: r.err}
+	} else {
+		return Option[error]{}
+	}
+}
+
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+func (r Result[T]) String() string {
+	if r.err == nil {
+		return fmt.Sprintf("Ok(%v)", r.value)
+	} else {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+}
+
+func (o Option[T]) OkOr(err error) Result[T] {
+	if o.valid {
+		return Result[T]{value: o.value}
+	} else {
+		return Err[T](err)
+	}
+}
+
+func (o Option[T]) OkOrElse(f func() error) Result[T] {
+	if o.valid {
+		return Result[T]{value: o.value}
+	} else {
+		return Err[T](f())
+	}
+}
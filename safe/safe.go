@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package safe
+
+import (
+	"fmt"
+
+	"github.com/antoniszymanski/option-go"
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+type Option[T any] struct {
+	o option.Option[T]
+}
+
+func Some[T any](value T) Option[T] {
+	return Option[T]{o: option.Some(value)}
+}
+
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+func From[T any](o option.Option[T]) Option[T] {
+	return Option[T]{o: o}
+}
+
+func (o Option[T]) IsSome() bool {
+	return o.o.IsSome()
+}
+
+func (o Option[T]) IsNone() bool {
+	return o.o.IsNone()
+}
+
+func (o Option[T]) IsSomeAnd(f func(T) bool) bool {
+	return o.o.IsSomeAnd(f)
+}
+
+func (o Option[T]) IsNoneOr(f func(T) bool) bool {
+	return o.o.IsNoneOr(f)
+}
+
+func (o Option[T]) Get() (T, bool) {
+	return o.o.UnwrapOrZero(), o.o.IsSome()
+}
+
+func (o Option[T]) UnwrapOr(fallback T) T {
+	return o.o.UnwrapOr(fallback)
+}
+
+func (o Option[T]) UnwrapOrZero() T {
+	return o.o.UnwrapOrZero()
+}
+
+func (o Option[T]) UnwrapOrElse(f func() T) T {
+	return o.o.UnwrapOrElse(f)
+}
+
+func (o Option[T]) Match(some func(T), none func()) {
+	o.o.Match(some, none)
+}
+
+func Match[T, R any](o Option[T], some func(T) R, none func() R) R {
+	return option.Match(o.o, some, none)
+}
+
+func (o Option[T]) Filter(predicate func(*T) bool) Option[T] {
+	return Option[T]{o: o.o.Filter(predicate)}
+}
+
+func (o Option[T]) Map(f func(T) T) Option[T] {
+	return Option[T]{o: o.o.Map(f)}
+}
+
+func (o Option[T]) And(other Option[T]) Option[T] {
+	return Option[T]{o: o.o.And(other.o)}
+}
+
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	return Option[T]{o: o.o.Or(other.o)}
+}
+
+func (o Option[T]) Xor(other Option[T]) Option[T] {
+	return Option[T]{o: o.o.Xor(other.o)}
+}
+
+func (o Option[T]) AndThen(f func(T) Option[T]) Option[T] {
+	if value, ok := o.Get(); ok {
+		return f(value)
+	} else {
+		return Option[T]{}
+	}
+}
+
+func (o Option[T]) OrElse(f func() Option[T]) Option[T] {
+	if o.IsSome() {
+		return o
+	} else {
+		return f()
+	}
+}
+
+var (
+	_ fmt.Stringer   = Option[int]{}
+	_ fmt.GoStringer = Option[int]{}
+)
+
+func (o Option[T]) String() string {
+	return o.o.String()
+}
+
+func (o Option[T]) GoString() string {
+	if value, ok := o.Get(); ok {
+		return fmt.Sprintf("safe.Some(%#v)", value)
+	} else {
+		return fmt.Sprintf("safe.None[%T]()", value)
+	}
+}
+
+var (
+	_ json.Marshaler       = Option[int]{}
+	_ json.Unmarshaler     = &Option[int]{}
+	_ json.MarshalerTo     = Option[int]{}
+	_ json.UnmarshalerFrom = &Option[int]{}
+)
+
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	return o.o.MarshalJSON()
+}
+
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	return o.o.UnmarshalJSON(data)
+}
+
+func (o Option[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return o.o.MarshalJSONTo(enc)
+}
+
+func (o *Option[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return o.o.UnmarshalJSONFrom(dec)
+}
+
+func (o Option[T]) IsZero() bool {
+	return o.o.IsZero()
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+type GapStrategy int
+
+const (
+	FillZero GapStrategy = iota
+	FillForward
+	FillInterpolate
+)
+
+func FillGaps(values []Option[float64], strategy GapStrategy) []float64 {
+	filled := make([]float64, len(values))
+	prev := -1
+	for i, v := range values {
+		if !v.valid {
+			continue
+		}
+		filled[i] = v.value
+		switch strategy {
+		case FillZero:
+		case FillForward:
+			start := prev + 1
+			fill := v.value
+			if prev >= 0 {
+				fill = filled[prev]
+			}
+			for j := start; j < i; j++ {
+				filled[j] = fill
+			}
+		case FillInterpolate:
+			if prev < 0 {
+				for j := range i {
+					filled[j] = v.value
+				}
+			} else {
+				step := (v.value - filled[prev]) / float64(i-prev)
+				for j := prev + 1; j < i; j++ {
+					filled[j] = filled[prev] + step*float64(j-prev)
+				}
+			}
+		}
+		prev = i
+	}
+	if strategy != FillZero && prev >= 0 {
+		for j := prev + 1; j < len(filled); j++ {
+			filled[j] = filled[prev]
+		}
+	}
+	return filled
+}
+
+func Downsample(values []Option[float64], size int, aggregate func([]float64) float64) []Option[float64] {
+	if size <= 0 {
+		panic("option: non-positive Downsample size")
+	}
+	samples := make([]Option[float64], 0, (len(values)+size-1)/size)
+	bucket := make([]float64, 0, size)
+	for start := 0; start < len(values); start += size {
+		bucket = bucket[:0]
+		for _, v := range values[start:min(start+size, len(values))] {
+			if v.valid {
+				bucket = append(bucket, v.value)
+			}
+		}
+		if len(bucket) > 0 {
+			samples = append(samples, Some(aggregate(bucket)))
+		} else {
+			samples = append(samples, Option[float64]{})
+		}
+	}
+	return samples
+}
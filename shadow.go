@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+type Shadow[T comparable] struct {
+	Primary Option[T]
+	Shadow  Option[T]
+}
+
+func NewShadow[T comparable](primary func() Option[T], shadow func() Option[T]) Shadow[T] {
+	return Shadow[T]{Primary: primary(), Shadow: shadow()}
+}
+
+func (s Shadow[T]) Matches() bool {
+	return s.Primary == s.Shadow
+}
+
+func (s Shadow[T]) Compare(report func(primary, shadow Option[T])) Option[T] {
+	if s.Primary != s.Shadow {
+		report(s.Primary, s.Shadow)
+	}
+	return s.Primary
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+func CollectSlice[T any](options []Option[T]) Option[[]T] {
+	values := make([]T, 0, len(options))
+	for _, o := range options {
+		if !o.valid {
+			return Option[[]T]{}
+		}
+		values = append(values, o.value)
+	}
+	return Option[[]T]{valid: true, value: values}
+}
+
+func FilterSome[T any](options []Option[T]) []T {
+	var values []T
+	for _, o := range options {
+		if o.valid {
+			values = append(values, o.value)
+		}
+	}
+	return values
+}
+
+func MapSlice[T, U any](values []T, f func(T) Option[U]) Option[[]U] {
+	mapped := make([]U, 0, len(values))
+	for _, value := range values {
+		o := f(value)
+		if !o.valid {
+			return Option[[]U]{}
+		}
+		mapped = append(mapped, o.value)
+	}
+	return Option[[]U]{valid: true, value: mapped}
+}
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "log/slog"
+
+var _ slog.LogValuer = Option[int]{}
+
+func (o Option[T]) LogValue() slog.Value {
+	if o.valid {
+		return slog.AnyValue(o.value)
+	} else {
+		return slog.AnyValue(nil)
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+var (
+	_ sql.Scanner   = &Option[int]{}
+	_ driver.Valuer = Option[int]{}
+)
+
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	err := n.Scan(src)
+	*o = FromLookup(n.V, n.Valid)
+	return err
+}
+
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.value, Valid: o.valid}.Value()
+}
+
+func FromSQLNull[T any](n sql.Null[T]) Option[T] {
+	return FromLookup(n.V, n.Valid)
+}
+
+func (o Option[T]) SQLNull() sql.Null[T] {
+	return sql.Null[T]{V: o.value, Valid: o.valid}
+}
+
+func FromNullString(n sql.NullString) Option[string] {
+	return FromLookup(n.String, n.Valid)
+}
+
+func NullString(o Option[string]) sql.NullString {
+	return sql.NullString{String: o.value, Valid: o.valid}
+}
+
+func FromNullInt64(n sql.NullInt64) Option[int64] {
+	return FromLookup(n.Int64, n.Valid)
+}
+
+func NullInt64(o Option[int64]) sql.NullInt64 {
+	return sql.NullInt64{Int64: o.value, Valid: o.valid}
+}
+
+func FromNullInt32(n sql.NullInt32) Option[int32] {
+	return FromLookup(n.Int32, n.Valid)
+}
+
+func NullInt32(o Option[int32]) sql.NullInt32 {
+	return sql.NullInt32{Int32: o.value, Valid: o.valid}
+}
+
+func FromNullInt16(n sql.NullInt16) Option[int16] {
+	return FromLookup(n.Int16, n.Valid)
+}
+
+func NullInt16(o Option[int16]) sql.NullInt16 {
+	return sql.NullInt16{Int16: o.value, Valid: o.valid}
+}
+
+func FromNullByte(n sql.NullByte) Option[byte] {
+	return FromLookup(n.Byte, n.Valid)
+}
+
+func NullByte(o Option[byte]) sql.NullByte {
+	return sql.NullByte{Byte: o.value, Valid: o.valid}
+}
+
+func FromNullFloat64(n sql.NullFloat64) Option[float64] {
+	return FromLookup(n.Float64, n.Valid)
+}
+
+func NullFloat64(o Option[float64]) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: o.value, Valid: o.valid}
+}
+
+func FromNullBool(n sql.NullBool) Option[bool] {
+	return FromLookup(n.Bool, n.Valid)
+}
+
+func NullBool(o Option[bool]) sql.NullBool {
+	return sql.NullBool{Bool: o.value, Valid: o.valid}
+}
+
+func FromNullTime(n sql.NullTime) Option[time.Time] {
+	return FromLookup(n.Time, n.Valid)
+}
+
+func NullTime(o Option[time.Time]) sql.NullTime {
+	return sql.NullTime{Time: o.value, Valid: o.valid}
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestFromSQLNullInvalid(t *testing.T) {
+	if got := FromSQLNull(sql.Null[int]{V: 5}); got != None[int]() {
+		t.Errorf("FromSQLNull(invalid) = %#v, want None", got)
+	}
+	if got := FromNullString(sql.NullString{String: "x"}); got != None[string]() {
+		t.Errorf("FromNullString(invalid) = %#v, want None", got)
+	}
+	if got := FromNullInt64(sql.NullInt64{Int64: 5}).UnwrapOrZero(); got != 0 {
+		t.Errorf("FromNullInt64(invalid).UnwrapOrZero() = %d, want 0", got)
+	}
+	if got := FromSQLNull(sql.Null[int]{V: 5, Valid: true}); got != Some(5) {
+		t.Errorf("FromSQLNull(valid) = %#v, want Some(5)", got)
+	}
+}
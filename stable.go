@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"fmt"
+
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+)
+
+const FormatVersion = 1
+
+type stableEnvelope[T any] struct {
+	Version int       `json:"v"`
+	Option  Option[T] `json:"o"`
+}
+
+func EncodeStable[T any](o Option[T]) ([]byte, error) {
+	data, err := json.Marshal(stableEnvelope[T]{Version: FormatVersion, Option: o}, Deterministic())
+	if err != nil {
+		return nil, err
+	}
+	v := jsontext.Value(data)
+	if err := v.Canonicalize(jsontext.CanonicalizeRawInts(false)); err != nil { // keep integers beyond 2^53 exact
+		return nil, err
+	}
+	return v, nil
+}
+
+func DecodeStable[T any](data []byte) (Option[T], error) {
+	var env stableEnvelope[T]
+	if err := json.Unmarshal(data, &env); err != nil {
+		return Option[T]{}, err
+	}
+	if env.Version != FormatVersion {
+		return Option[T]{}, fmt.Errorf("option: unsupported stable format version %d", env.Version)
+	}
+	return env.Option, nil
+}
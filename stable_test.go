@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type stableRecord struct {
+	Name  string            `json:"name"`
+	Tags  map[string]string `json:"tags"`
+	Score Option[float64]   `json:"score"`
+}
+
+func stableCase[T any](o Option[T]) func(*testing.T, []byte) {
+	return func(t *testing.T, golden []byte) {
+		data, err := EncodeStable(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, golden) {
+			t.Errorf("EncodeStable(%v) = %s, want %s", o, data, golden)
+		}
+		got, err := DecodeStable[T](golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, o) {
+			t.Errorf("DecodeStable(%s) = %v, want %v", golden, got, o)
+		}
+	}
+}
+
+func TestStableGolden(t *testing.T) {
+	tests := map[string]func(*testing.T, []byte){
+		"none":   stableCase(None[int]()),
+		"int":    stableCase(Some(-42)),
+		"int64":  stableCase(Some(int64(1<<60 + 1))),
+		"uint64": stableCase(Some(uint64(1<<64 - 1))),
+		"float":  stableCase(Some(1e21)),
+		"string": stableCase(Some("<é>\u2028\"")),
+		"time":   stableCase(Some(time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC))),
+		"record": stableCase(Some(stableRecord{
+			Name:  "x",
+			Tags:  map[string]string{"b": "2", "a": "1", "é": "3"},
+			Score: Some(0.5),
+		})),
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			golden, err := os.ReadFile(filepath.Join("testdata", "stable", name+".golden"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			test(t, golden)
+		})
+	}
+}
+
+func TestDecodeStableVersion(t *testing.T) {
+	if _, err := DecodeStable[int]([]byte(`{"o":1,"v":2}`)); err == nil {
+		t.Error("DecodeStable accepted an unknown format version")
+	}
+	if _, err := DecodeStable[int]([]byte(`1`)); err == nil {
+		t.Error("DecodeStable accepted data without a version")
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func CountSome[T any](values []Option[T]) int {
+	var n int
+	for _, v := range values {
+		if v.valid {
+			n++
+		}
+	}
+	return n
+}
+
+func MeanSome[N Number](values []Option[N]) Option[float64] {
+	var sum float64
+	var n int
+	for _, v := range values {
+		if v.valid {
+			sum += float64(v.value)
+			n++
+		}
+	}
+	if n > 0 {
+		return Some(sum / float64(n))
+	} else {
+		return Option[float64]{}
+	}
+}
+
+func MedianSome[N Number](values []Option[N]) Option[float64] {
+	return PercentileSome(values, 50)
+}
+
+func PercentileSome[N Number](values []Option[N], p float64) Option[float64] {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		panic("option: percentile out of range")
+	}
+	samples := make([]float64, 0, len(values))
+	for _, v := range values {
+		if v.valid {
+			samples = append(samples, float64(v.value))
+		}
+	}
+	if len(samples) == 0 {
+		return Option[float64]{}
+	}
+	slices.Sort(samples)
+	rank := p / 100 * float64(len(samples)-1)
+	lo, hi := int(math.Floor(rank)), int(math.Ceil(rank))
+	return Some(samples[lo] + (samples[hi]-samples[lo])*(rank-float64(lo)))
+}
+
+type NonePolicy int
+
+const (
+	SkipNone NonePolicy = iota
+	PropagateNone
+)
+
+func Fold[T, A any](values []Option[T], init A, policy NonePolicy, f func(A, T) A) Option[A] {
+	acc, seen := init, false
+	for _, v := range values {
+		if v.valid {
+			acc, seen = f(acc, v.value), true
+		} else if policy == PropagateNone {
+			return Option[A]{}
+		}
+	}
+	if seen {
+		return Some(acc)
+	} else {
+		return Option[A]{}
+	}
+}
+
+func Sum[N Number](values []Option[N], policy NonePolicy) Option[N] {
+	return Fold(values, 0, policy, func(sum, v N) N {
+		return sum + v
+	})
+}
+
+func Min[N cmp.Ordered](values []Option[N], policy NonePolicy) Option[N] {
+	return Flatten(Fold(values, Option[N]{}, policy, func(m Option[N], v N) Option[N] {
+		if !m.valid || cmp.Less(v, m.value) {
+			return Some(v)
+		} else {
+			return m
+		}
+	}))
+}
+
+func Max[N cmp.Ordered](values []Option[N], policy NonePolicy) Option[N] {
+	return Flatten(Fold(values, Option[N]{}, policy, func(m Option[N], v N) Option[N] {
+		if !m.valid || cmp.Less(m.value, v) {
+			return Some(v)
+		} else {
+			return m
+		}
+	}))
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Table[K comparable, V any] struct {
+	size    int
+	ttl     time.Duration
+	onEvict func(K, V)
+
+	mu     sync.Mutex
+	lru    list.List
+	items  map[K]*list.Element
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type tableEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+func NewTable[K comparable, V any](size int, ttl time.Duration, onEvict func(K, V)) *Table[K, V] {
+	return &Table[K, V]{size: size, ttl: ttl, onEvict: onEvict, items: make(map[K]*list.Element)}
+}
+
+func (t *Table[K, V]) Get(key K) Option[V] {
+	t.mu.Lock()
+	e, ok := t.items[key]
+	if !ok {
+		t.mu.Unlock()
+		t.misses.Add(1)
+		return Option[V]{}
+	}
+	entry := e.Value.(*tableEntry[K, V])
+	if t.ttl > 0 && time.Now().After(entry.expires) {
+		t.remove(e)
+		t.mu.Unlock()
+		t.misses.Add(1)
+		t.evicted(entry)
+		return Option[V]{}
+	}
+	t.lru.MoveToFront(e)
+	t.mu.Unlock()
+	t.hits.Add(1)
+	return Some(entry.value)
+}
+
+func (t *Table[K, V]) Set(key K, value V) {
+	var expires time.Time
+	if t.ttl > 0 {
+		expires = time.Now().Add(t.ttl)
+	}
+	t.mu.Lock()
+	if e, ok := t.items[key]; ok {
+		entry := e.Value.(*tableEntry[K, V])
+		entry.value, entry.expires = value, expires
+		t.lru.MoveToFront(e)
+		t.mu.Unlock()
+		return
+	}
+	t.items[key] = t.lru.PushFront(&tableEntry[K, V]{key: key, value: value, expires: expires})
+	var evicted *tableEntry[K, V]
+	if t.size > 0 && t.lru.Len() > t.size {
+		evicted = t.remove(t.lru.Back())
+	}
+	t.mu.Unlock()
+	if evicted != nil {
+		t.evicted(evicted)
+	}
+}
+
+func (t *Table[K, V]) Delete(key K) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if e, ok := t.items[key]; ok {
+		t.remove(e)
+	}
+}
+
+func (t *Table[K, V]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lru.Len()
+}
+
+func (t *Table[K, V]) Hits() uint64 {
+	return t.hits.Load()
+}
+
+func (t *Table[K, V]) Misses() uint64 {
+	return t.misses.Load()
+}
+
+func (t *Table[K, V]) remove(e *list.Element) *tableEntry[K, V] {
+	entry := t.lru.Remove(e).(*tableEntry[K, V])
+	delete(t.items, entry.key)
+	return entry
+}
+
+func (t *Table[K, V]) evicted(entry *tableEntry[K, V]) {
+	if t.onEvict != nil {
+		t.onEvict(entry.key, entry.value)
+	}
+}
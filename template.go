@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "reflect"
+
+func TemplateFuncs() map[string]any {
+	return map[string]any{
+		"isSome": func(o any) bool {
+			_, ok := unpackAny(o)
+			return ok
+		},
+		"unwrapOr": func(fallback, o any) any {
+			if value, ok := unpackAny(o); ok {
+				return value
+			} else {
+				return fallback
+			}
+		},
+		"deref": func(o any) any {
+			if value, ok := unpackAny(o); ok {
+				return value
+			} else {
+				return ""
+			}
+		},
+	}
+}
+
+func unpackAny(o any) (any, bool) {
+	v := reflect.ValueOf(o)
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if value, ok := Unpack(v); ok {
+		return value.Interface(), true
+	} else {
+		return nil, false
+	}
+}
+
+func (o Option[T]) OrEmpty() any {
+	if o.valid {
+		return o.value
+	} else {
+		return ""
+	}
+}
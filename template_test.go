@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+type templatePerson struct {
+	Name Option[string]
+	Age  Option[int]
+}
+
+func TestTemplateOrEmpty(t *testing.T) {
+	tmpl := template.Must(template.New("").Funcs(TemplateFuncs()).Parse(
+		`{{.Name.OrEmpty}}|{{.Age.OrEmpty}}|{{if isSome .Age}}set{{else}}unset{{end}}|{{unwrapOr 0 .Age}}`,
+	))
+	tests := []struct {
+		data templatePerson
+		want string
+	}{
+		{templatePerson{}, "||unset|0"},
+		{templatePerson{Name: Some("Ann"), Age: Some(30)}, "Ann|30|set|30"},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		if err := tmpl.Execute(&b, tt.data); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != tt.want {
+			t.Errorf("Execute(%+v) = %q, want %q", tt.data, b.String(), tt.want)
+		}
+	}
+}
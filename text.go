@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	_ encoding.TextMarshaler   = Option[int]{}
+	_ encoding.TextUnmarshaler = &Option[int]{}
+)
+
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if o.valid {
+		return marshalText(&o.value)
+	} else {
+		return []byte{}, nil
+	}
+}
+
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = Option[T]{}
+		return nil
+	}
+	if err := unmarshalText(&o.value, text); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	o.valid = true
+	return nil
+}
+
+func marshalText[T any](p *T) ([]byte, error) {
+	if m, ok := marshalerOf[encoding.TextMarshaler](p); ok {
+		return m.MarshalText()
+	}
+	if d, ok := any(p).(*time.Duration); ok {
+		return []byte(d.String()), nil
+	}
+	v := reflect.ValueOf(p).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("option: cannot marshal %s as text", v.Type())
+	}
+}
+
+func unmarshalText[T any](p *T, text []byte) error {
+	if u, ok := unmarshalerOf[encoding.TextUnmarshaler](p); ok {
+		return u.UnmarshalText(text)
+	}
+	if d, ok := any(p).(*time.Duration); ok {
+		var err error
+		*d, err = time.ParseDuration(string(text))
+		return err
+	}
+	v := reflect.ValueOf(p).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(string(text))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(string(text))
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(string(text), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("option: cannot unmarshal text into %s", v.Type())
+	}
+	return nil
+}
+
+func marshalerOf[I, T any](p *T) (I, bool) {
+	if m, ok := any(p).(I); ok {
+		return m, true
+	}
+	if v := reflect.ValueOf(p).Elem(); v.Kind() == reflect.Pointer && !v.IsNil() {
+		m, ok := any(*p).(I)
+		return m, ok
+	}
+	var zero I
+	return zero, false
+}
+
+func unmarshalerOf[I, T any](p *T) (I, bool) {
+	if u, ok := any(p).(I); ok {
+		return u, true
+	}
+	if v := reflect.ValueOf(p).Elem(); v.Kind() == reflect.Pointer && v.Type().Implements(reflect.TypeFor[I]()) {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return any(*p).(I), true
+	}
+	var zero I
+	return zero, false
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build option_toml
+
+package option
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/BurntSushi/toml"
+)
+
+var (
+	_ toml.Marshaler   = Option[int]{}
+	_ toml.Unmarshaler = &Option[int]{}
+)
+
+func (o Option[T]) MarshalTOML() ([]byte, error) {
+	if !o.valid {
+		return nil, errors.New("option: None has no TOML representation; use the omitempty tag or a pointer field")
+	}
+	var buf bytes.Buffer
+	doc := struct {
+		V *T `toml:"v"`
+	}{&o.value}
+	if err := toml.NewEncoder(&buf).Encode(doc); err != nil {
+		return nil, err
+	}
+	var decoded map[string]any
+	if _, err := toml.NewDecoder(&buf).Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return appendTOMLInline(nil, decoded["v"])
+}
+
+func appendTOMLInline(b []byte, value any) ([]byte, error) {
+	switch value := value.(type) {
+	case map[string]any:
+		b = append(b, '{')
+		for i, key := range slices.Sorted(maps.Keys(value)) {
+			if i > 0 {
+				b = append(b, ", "...)
+			}
+			b = append(b, toml.Key{key}.String()...)
+			b = append(b, " = "...)
+			var err error
+			if b, err = appendTOMLInline(b, value[key]); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, '}'), nil
+	case []map[string]any:
+		b = append(b, '[')
+		for i, elem := range value {
+			if i > 0 {
+				b = append(b, ", "...)
+			}
+			var err error
+			if b, err = appendTOMLInline(b, elem); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	case []any:
+		b = append(b, '[')
+		for i, elem := range value {
+			if i > 0 {
+				b = append(b, ", "...)
+			}
+			var err error
+			if b, err = appendTOMLInline(b, elem); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	default:
+		var buf bytes.Buffer
+		if err := toml.NewEncoder(&buf).Encode(map[string]any{"v": value}); err != nil {
+			return nil, err
+		}
+		return append(b, bytes.TrimSpace(bytes.TrimPrefix(buf.Bytes(), []byte("v = ")))...), nil
+	}
+}
+
+func (o *Option[T]) UnmarshalTOML(data any) error {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(map[string]any{"v": data}); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	var doc struct {
+		V T `toml:"v"`
+	}
+	if _, err := toml.NewDecoder(&buf).Decode(&doc); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	*o = Option[T]{valid: true, value: doc.V}
+	return nil
+}
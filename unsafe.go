@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !purego
+
+package option
+
+import (
+	"reflect"
+	"structs"
+	"unsafe"
+)
+
+type hostLayout = structs.HostLayout
+
+func asSlice[T any](p *T) []T {
+	return unsafe.Slice(p, 1)
+}
+
+func IsOption(typ reflect.Type) bool {
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := (*(*any)(unsafe.Pointer(&iface{
+		Type: (*iface)(unsafe.Pointer(&typ)).Data,
+		Data: nil,
+	}))).(interface{ isOption() })
+	return ok
+}
+
+//go:nosplit
+func elem[P ~*E, E any](p P) any {
+	if p == nil {
+		return nil
+	}
+	typ := reflect.TypeFor[E]()
+	if typ.Kind() == reflect.Interface || isDirectIface(typ) {
+		return any(*p) // does not allocate
+	}
+	return *(*any)(unsafe.Pointer(&iface{
+		Type: (*iface)(unsafe.Pointer(&typ)).Data,
+		Data: unsafe.Pointer(noEscape(p)),
+	}))
+}
+
+func isDirectIface(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return true
+	case reflect.Array:
+		return typ.Len() == 1 && isDirectIface(typ.Elem())
+	case reflect.Struct:
+		return typ.NumField() == 1 && isDirectIface(typ.Field(0).Type)
+	default:
+		return false
+	}
+}
+
+type iface struct {
+	Type, Data unsafe.Pointer
+}
+
+//go:nosplit
+func noEscape[P ~*E, E any](p P) P {
+	x := uintptr(unsafe.Pointer(p))
+	return P(unsafe.Pointer(x ^ 0)) //nolint:all
+}
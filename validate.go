@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+func (o Option[T]) Validate() error {
+	if !o.valid {
+		return nil
+	}
+	if v, ok := any(&o.value).(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+func WalkOptions(v any, fn func(path string, isSome bool, value any) error) error {
+	w := optionWalker{fn: fn, seen: make(map[uintptr]bool)}
+	w.walk(reflect.ValueOf(v), "")
+	return errors.Join(w.errs...)
+}
+
+type optionWalker struct {
+	fn   func(path string, isSome bool, value any) error
+	errs []error
+	seen map[uintptr]bool
+}
+
+func (w *optionWalker) walk(v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return
+	case reflect.Pointer:
+		if !v.IsNil() && !w.seen[v.Pointer()] {
+			w.seen[v.Pointer()] = true
+			w.walk(v.Elem(), path)
+		}
+		return
+	case reflect.Interface:
+		if !v.IsNil() {
+			w.walk(v.Elem(), path)
+		}
+		return
+	}
+	if IsOption(v.Type()) {
+		value, ok := Unpack(v)
+		var inner any
+		if ok {
+			inner = value.Interface()
+		}
+		if err := w.fn(path, ok, inner); err != nil {
+			w.errs = append(w.errs, err)
+		}
+		if ok {
+			w.walk(value, path)
+		}
+		return
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if sf := v.Type().Field(i); sf.IsExported() {
+				w.walk(v.Field(i), joinPath(path, sf.Name))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			w.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			w.walk(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()))
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	} else {
+		return path + "." + name
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import "encoding/xml"
+
+var (
+	_ xml.Marshaler       = Option[int]{}
+	_ xml.Unmarshaler     = &Option[int]{}
+	_ xml.MarshalerAttr   = Option[int]{}
+	_ xml.UnmarshalerAttr = &Option[int]{}
+)
+
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if o.valid {
+		return e.EncodeElement(&o.value, start)
+	} else {
+		return nil
+	}
+}
+
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if isXSINil(start) {
+		*o = Option[T]{}
+		return d.Skip()
+	}
+	if err := d.DecodeElement(&o.value, &start); err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	o.valid = true
+	return nil
+}
+
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.valid {
+		return xml.Attr{}, nil
+	}
+	if m, ok := any(&o.value).(xml.MarshalerAttr); ok {
+		return m.MarshalXMLAttr(name)
+	}
+	text, err := marshalText(&o.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+func (o *Option[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var err error
+	if u, ok := any(&o.value).(xml.UnmarshalerAttr); ok {
+		err = u.UnmarshalXMLAttr(attr)
+	} else {
+		err = unmarshalText(&o.value, []byte(attr.Value))
+	}
+	if err != nil {
+		*o = Option[T]{}
+		return err
+	}
+	o.valid = true
+	return nil
+}
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+func isXSINil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") && attr.Name.Local == "nil" { // "xsi" when the prefix is undeclared
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
+
+type Nillable[T any] struct {
+	Option[T]
+}
+
+var (
+	_ xml.Marshaler   = Nillable[int]{}
+	_ xml.Unmarshaler = &Nillable[int]{}
+)
+
+func (n Nillable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if n.valid {
+		return n.Option.MarshalXML(e, start)
+	}
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
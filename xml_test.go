@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlNillableDoc struct {
+	XMLName xml.Name         `xml:"doc"`
+	Opt     Option[string]   `xml:"opt"`
+	Nil     Nillable[string] `xml:"nil"`
+	Empty   Nillable[string] `xml:"empty"`
+	Value   Nillable[int]    `xml:"value"`
+}
+
+func TestXMLNillable(t *testing.T) {
+	doc := xmlNillableDoc{Empty: Nillable[string]{Some("")}, Value: Nillable[int]{Some(7)}}
+	data, err := xml.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<doc><nil xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></nil><empty></empty><value>7</value></doc>`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	var got xmlNillableDoc
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Opt != doc.Opt || got.Nil != doc.Nil || got.Empty != doc.Empty || got.Value != doc.Value {
+		t.Errorf("Unmarshal(%s) = %+v, want %+v", data, got, doc)
+	}
+}
+
+func TestXMLUnmarshalXSINil(t *testing.T) {
+	for _, data := range []string{
+		`<doc xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><opt xsi:nil="true"/><value xsi:nil="1"></value></doc>`,
+		`<doc><opt xsi:nil="true">ignored</opt><value xsi:nil="true"/></doc>`,
+	} {
+		got := xmlNillableDoc{Opt: Some("x"), Value: Nillable[int]{Some(1)}}
+		if err := xml.Unmarshal([]byte(data), &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Opt.IsSome() || got.Value.IsSome() {
+			t.Errorf("Unmarshal(%s) = %+v, want None fields", data, got)
+		}
+	}
+}
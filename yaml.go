@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 Antoni Szymański
+// SPDX-License-Identifier: MPL-2.0
+
+package option
+
+func (o Option[T]) MarshalYAML() (any, error) {
+	if o.valid {
+		return o.value, nil
+	} else {
+		return nil, nil
+	}
+}
+
+func (o *Option[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var value T
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	*o = Option[T]{valid: true, value: value}
+	return nil
+}